@@ -60,6 +60,9 @@ func ProtoLoadBalancerToLoadBalancer(dpdkLB *proto.GetLoadBalancerResponse, lbID
 
 func StringLbportToLbport(lbport string) (LBPort, error) {
 	p := strings.Split(lbport, "/")
+	if len(p) != 2 {
+		return LBPort{}, fmt.Errorf("expected <protocol>/<port>")
+	}
 	protocolName := strings.ToLower(p[0])
 	switch protocolName {
 	case "icmp", "tcp", "udp", "sctp":
@@ -77,6 +80,8 @@ func StringLbportToLbport(lbport string) (LBPort, error) {
 	return LBPort{Protocol: uint32(protocol), Port: uint32(port)}, nil
 }
 
+// ProtoInterfaceToInterface converts an interface returned by dp-service.
+// The proto message has no interface type, Spec.Type stays empty.
 func ProtoInterfaceToInterface(dpdkIface *proto.Interface) (*Interface, error) {
 	var underlayRoute netip.Addr
 	if underlayRouteString := string(dpdkIface.GetUnderlayRoute()); underlayRouteString != "" {
@@ -329,6 +334,42 @@ func ProtoFwRuleToFwRule(dpdkFwRule *proto.FirewallRule, interfaceID string) (*F
 	}, nil
 }
 
+// NormalizeFirewallRule returns direction and action in the form dp-service reports them, Ingress or Egress
+// and Accept or Drop. Both are case insensitive, 0 and 1 as well as Allow and Deny are accepted too.
+func NormalizeFirewallRule(direction, action string) (string, string, error) {
+	normalizedDirection, ok := normalizeTrafficDirection(direction)
+	if !ok {
+		return "", "", fmt.Errorf("traffic direction can be only: Ingress = 0/Egress = 1")
+	}
+	normalizedAction, ok := normalizeFirewallAction(action)
+	if !ok {
+		return "", "", fmt.Errorf("firewall action can be only: drop/deny/0|accept/allow/1")
+	}
+	return normalizedDirection, normalizedAction, nil
+}
+
+func normalizeTrafficDirection(direction string) (string, bool) {
+	switch strings.ToLower(direction) {
+	case "ingress", "0":
+		return "Ingress", true
+	case "egress", "1":
+		return "Egress", true
+	default:
+		return "", false
+	}
+}
+
+func normalizeFirewallAction(action string) (string, bool) {
+	switch strings.ToLower(action) {
+	case "accept", "allow", "1":
+		return "Accept", true
+	case "drop", "deny", "0":
+		return "Drop", true
+	default:
+		return "", false
+	}
+}
+
 func ProtoStatusToStatus(dpdkStatus *proto.Status) Status {
 	if dpdkStatus == nil {
 		return Status{
@@ -342,6 +383,28 @@ func ProtoStatusToStatus(dpdkStatus *proto.Status) Status {
 	}
 }
 
+func InterfaceTypeToProtoInterfaceType(interfaceType string) (proto.InterfaceType, error) {
+	switch interfaceType {
+	case "", InterfaceTypeVirtual:
+		return proto.InterfaceType_VIRTUAL, nil
+	case InterfaceTypeBareMetal:
+		return proto.InterfaceType_BAREMETAL, nil
+	default:
+		return 0, fmt.Errorf("unsupported interface type %q", interfaceType)
+	}
+}
+
+func ProtoInterfaceTypeToInterfaceType(interfaceType proto.InterfaceType) (string, error) {
+	switch interfaceType {
+	case proto.InterfaceType_VIRTUAL:
+		return InterfaceTypeVirtual, nil
+	case proto.InterfaceType_BAREMETAL:
+		return InterfaceTypeBareMetal, nil
+	default:
+		return "", fmt.Errorf("unsupported interface type %d", interfaceType)
+	}
+}
+
 func CaptureIfaceTypeToProtoIfaceType(interfaceType string) (proto.CaptureInterfaceType, error) {
 	switch interfaceType {
 	case "pf":
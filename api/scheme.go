@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Scheme maps kinds to the types decoded for them.
+type Scheme struct {
+	objects map[string]func() Object
+	lists   map[string]func() List
+}
+
+// NewScheme returns an empty scheme.
+func NewScheme() *Scheme {
+	return &Scheme{
+		objects: map[string]func() Object{},
+		lists:   map[string]func() List{},
+	}
+}
+
+// DefaultScheme knows all kinds of this package.
+var DefaultScheme = NewScheme()
+
+func init() {
+	DefaultScheme.Register(InterfaceKind, func() Object { return &Interface{} })
+	DefaultScheme.Register(LoadBalancerKind, func() Object { return &LoadBalancer{} })
+	DefaultScheme.Register(LoadBalancerTargetKind, func() Object { return &LoadBalancerTarget{} })
+	DefaultScheme.Register(LoadBalancerPrefixKind, func() Object { return &LoadBalancerPrefix{} })
+	DefaultScheme.Register(PrefixKind, func() Object { return &Prefix{} })
+	DefaultScheme.Register(VirtualIPKind, func() Object { return &VirtualIP{} })
+	DefaultScheme.Register(RouteKind, func() Object { return &Route{} })
+	DefaultScheme.Register(NatKind, func() Object { return &Nat{} })
+	DefaultScheme.Register(NeighborNatKind, func() Object { return &NeighborNat{} })
+	DefaultScheme.Register(FirewallRuleKind, func() Object { return &FirewallRule{} })
+	DefaultScheme.Register(InitializedKind, func() Object { return &Initialized{} })
+	DefaultScheme.Register(VniKind, func() Object { return &Vni{} })
+	DefaultScheme.Register(VersionKind, func() Object { return &Version{} })
+	DefaultScheme.Register(CaptureStartKind, func() Object { return &CaptureStart{} })
+	DefaultScheme.Register(CaptureStopKind, func() Object { return &CaptureStop{} })
+	DefaultScheme.Register(CaptureStatusKind, func() Object { return &CaptureStatus{} })
+
+	DefaultScheme.RegisterList(InterfaceListKind, func() List { return &InterfaceList{} })
+	DefaultScheme.RegisterList(LoadBalancerTargetListKind, func() List { return &LoadBalancerTargetList{} })
+	DefaultScheme.RegisterList(PrefixListKind, func() List { return &PrefixList{} })
+	DefaultScheme.RegisterList(RouteListKind, func() List { return &RouteList{} })
+	DefaultScheme.RegisterList(NatListKind, func() List { return &NatList{} })
+	DefaultScheme.RegisterList(FirewallRuleListKind, func() List { return &FirewallRuleList{} })
+}
+
+// Register makes kind decode into the object returned by newObject.
+func (s *Scheme) Register(kind string, newObject func() Object) {
+	s.objects[kind] = newObject
+}
+
+// RegisterList makes kind decode into the list returned by newList.
+func (s *Scheme) RegisterList(kind string, newList func() List) {
+	s.lists[kind] = newList
+}
+
+// Decode decodes a single JSON or YAML document into the type registered for its kind.
+// Lists are flattened into their items.
+func (s *Scheme) Decode(data []byte) ([]Object, error) {
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("error converting yaml to json: %w", err)
+	}
+
+	meta := &TypeMeta{}
+	if err := json.Unmarshal(jsonData, meta); err != nil {
+		return nil, fmt.Errorf("error decoding kind: %w", err)
+	}
+	if meta.Kind == "" {
+		return nil, fmt.Errorf("kind is not set")
+	}
+
+	if newObject, ok := s.objects[meta.Kind]; ok {
+		obj := newObject()
+		if err := unmarshalStrict(jsonData, obj); err != nil {
+			return nil, fmt.Errorf("error decoding %s: %w", meta.Kind, err)
+		}
+		return []Object{obj}, nil
+	}
+	if newList, ok := s.lists[meta.Kind]; ok {
+		list := newList()
+		if err := unmarshalStrict(jsonData, list); err != nil {
+			return nil, fmt.Errorf("error decoding %s: %w", meta.Kind, err)
+		}
+		return list.GetItems(), nil
+	}
+	return nil, fmt.Errorf("unknown kind %q", meta.Kind)
+}
+
+// DecodeAll decodes a manifest of JSON or YAML documents separated by "---" lines.
+// Empty documents are skipped.
+func (s *Scheme) DecodeAll(r io.Reader) ([]Object, error) {
+	var objs []Object
+	var doc bytes.Buffer
+	index := 0
+
+	decode := func() error {
+		defer doc.Reset()
+		index++
+		if isEmptyDocument(doc.Bytes()) {
+			return nil
+		}
+		docObjs, err := s.Decode(doc.Bytes())
+		if err != nil {
+			return fmt.Errorf("document %d: %w", index, err)
+		}
+		objs = append(objs, docObjs...)
+		return nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimRight(line, " \t") == "---" {
+			if err := decode(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		doc.WriteString(line)
+		doc.WriteByte('\n')
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading manifest: %w", err)
+	}
+	if err := decode(); err != nil {
+		return nil, err
+	}
+	return objs, nil
+}
+
+// Decode decodes a single document using DefaultScheme.
+func Decode(data []byte) ([]Object, error) {
+	return DefaultScheme.Decode(data)
+}
+
+// DecodeAll decodes a multi-document manifest using DefaultScheme.
+func DecodeAll(r io.Reader) ([]Object, error) {
+	return DefaultScheme.DecodeAll(r)
+}
+
+func isEmptyDocument(data []byte) bool {
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			return false
+		}
+	}
+	return true
+}
+
+func unmarshalStrict(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
@@ -4,11 +4,13 @@
 package api
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/netip"
 	"reflect"
 
 	proto "github.com/ironcore-dev/dpservice-go/proto"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 type Object interface {
@@ -300,7 +302,15 @@ func (m *Interface) GetStatus() Status {
 	return m.Status
 }
 
+const (
+	InterfaceTypeVirtual   = "virtual"
+	InterfaceTypeBareMetal = "baremetal"
+)
+
 type InterfaceSpec struct {
+	// Type is InterfaceTypeVirtual (the default if empty) or InterfaceTypeBareMetal.
+	// dp-service does not report it for existing interfaces, so it is empty in Get and List results.
+	Type            string           `json:"type,omitempty"`
 	VNI             uint32           `json:"vni"`
 	Device          string           `json:"device,omitempty"`
 	IPv4            *netip.Addr      `json:"primary_ipv4,omitempty"`
@@ -310,6 +320,8 @@ type InterfaceSpec struct {
 	PXE             *PXE             `json:"pxe,omitempty"`
 	Nat             *Nat             `json:"-"`
 	VIP             *VirtualIP       `json:"-"`
+	Prefixes        []Prefix         `json:"-"`
+	FirewallRules   []FirewallRule   `json:"-"`
 	Metering        *MeteringParams  `json:"metering,omitempty"`
 }
 
@@ -451,6 +463,42 @@ type FirewallRuleSpec struct {
 	ProtocolFilter    *proto.ProtocolFilter `json:"protocol_filter,omitempty"`
 }
 
+type firewallRuleSpec FirewallRuleSpec
+
+// MarshalJSON encodes the protocol filter with protojson, as its oneof cannot be handled by encoding/json.
+func (s FirewallRuleSpec) MarshalJSON() ([]byte, error) {
+	aux := struct {
+		firewallRuleSpec
+		ProtocolFilter json.RawMessage `json:"protocol_filter,omitempty"`
+	}{firewallRuleSpec: firewallRuleSpec(s)}
+	if s.ProtocolFilter != nil {
+		filter, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(s.ProtocolFilter)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding protocol filter: %w", err)
+		}
+		aux.ProtocolFilter = filter
+	}
+	return json.Marshal(aux)
+}
+
+func (s *FirewallRuleSpec) UnmarshalJSON(data []byte) error {
+	aux := struct {
+		*firewallRuleSpec
+		ProtocolFilter json.RawMessage `json:"protocol_filter,omitempty"`
+	}{firewallRuleSpec: (*firewallRuleSpec)(s)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	s.ProtocolFilter = nil
+	if len(aux.ProtocolFilter) > 0 && string(aux.ProtocolFilter) != "null" {
+		s.ProtocolFilter = &proto.ProtocolFilter{}
+		if err := protojson.Unmarshal(aux.ProtocolFilter, s.ProtocolFilter); err != nil {
+			return fmt.Errorf("error decoding protocol filter: %w", err)
+		}
+	}
+	return nil
+}
+
 type FirewallRuleList struct {
 	TypeMeta             `json:",inline"`
 	FirewallRuleListMeta `json:"metadata"`
@@ -558,8 +606,8 @@ type CaptureConfig struct {
 type CaptureStart struct {
 	TypeMeta         `json:",inline"`
 	CaptureStartMeta `json:"metadata"`
-	Spec             CaptureStartSpec `json:"spec"`
-	Status           Status           `json:"status"`
+	Spec             CaptureStartSpec   `json:"spec"`
+	Status           CaptureStartStatus `json:"status"`
 }
 
 type CaptureStartMeta struct {
@@ -570,12 +618,18 @@ type CaptureStartSpec struct {
 	Interfaces []CaptureInterface `json:"interfaces,omitempty"`
 }
 
+type CaptureStartStatus struct {
+	Status `json:",inline"`
+	// SkippedInterfaces are the invalid interfaces CaptureStart skipped when called with client.WithLenientCapture.
+	SkippedInterfaces []CaptureInterface `json:"skipped_interfaces,omitempty"`
+}
+
 func (m *CaptureStartMeta) GetName() string {
 	return m.Config.SinkNodeIP.String()
 }
 
 func (m *CaptureStart) GetStatus() Status {
-	return m.Status
+	return m.Status.Status
 }
 
 type CaptureInterface struct {
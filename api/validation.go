@@ -0,0 +1,387 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	proto "github.com/ironcore-dev/dpservice-go/proto"
+)
+
+// MaxVNI is the largest VNI dp-service accepts, VNIs are 24 bit.
+const MaxVNI = 1<<24 - 1
+
+// FieldError describes a single invalid field.
+type FieldError struct {
+	// Field is the path of the field, e.g. spec.next_hop.address.
+	Field  string
+	Detail string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Detail)
+}
+
+// ValidationError aggregates all invalid fields of an object or spec.
+type ValidationError struct {
+	Kind   string
+	Errors []*FieldError
+}
+
+func (e *ValidationError) Error() string {
+	errs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err.Error()
+	}
+	return fmt.Sprintf("invalid %s: %s", e.Kind, strings.Join(errs, ", "))
+}
+
+type validator struct {
+	errs []*FieldError
+}
+
+func (v *validator) add(field, format string, args ...any) {
+	v.errs = append(v.errs, &FieldError{Field: field, Detail: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) result(kind string) error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return &ValidationError{Kind: kind, Errors: v.errs}
+}
+
+func fieldPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+func (v *validator) required(field, value string) {
+	if value == "" {
+		v.add(field, "is required")
+	}
+}
+
+func (v *validator) vni(field string, vni uint32) {
+	if vni > MaxVNI {
+		v.add(field, "must be at most %d", MaxVNI)
+	}
+}
+
+// addr checks addr is set and, if family is 4 or 6, of that IP family.
+func (v *validator) addr(field string, addr *netip.Addr, family int) {
+	switch {
+	case addr == nil || !addr.IsValid():
+		v.add(field, "is required")
+	case family == 4 && !addr.Is4():
+		v.add(field, "must be an IPv4 address")
+	case family == 6 && !addr.Is6():
+		v.add(field, "must be an IPv6 address")
+	}
+}
+
+func (v *validator) prefix(field string, prefix *netip.Prefix) {
+	switch {
+	case prefix == nil || !prefix.IsValid():
+		v.add(field, "is required")
+	case prefix.Masked() != *prefix:
+		v.add(field, "must not have host bits set, use %s", prefix.Masked())
+	}
+}
+
+// natPortRange checks a NAT port range [minPort, maxPort). dp-service splits the
+// port space into equally sized ranges, so minPort has to be a multiple of the range size.
+func (v *validator) natPortRange(parent string, minPort, maxPort uint32) {
+	switch {
+	case maxPort > 0xffff:
+		v.add(fieldPath(parent, "max_port"), "must be at most 65535")
+	case minPort >= maxPort:
+		v.add(fieldPath(parent, "max_port"), "must be greater than min_port")
+	case minPort%(maxPort-minPort) != 0:
+		v.add(fieldPath(parent, "min_port"), "must be a multiple of the range size %d", maxPort-minPort)
+	}
+}
+
+func (v *validator) filterPortRange(parent string, lower, upper int32) {
+	validPort := func(port int32) bool {
+		return port >= -1 && port <= 0xffff
+	}
+	if !validPort(lower) {
+		v.add(parent+"_port_lower", "must be between -1 and 65535")
+	}
+	if !validPort(upper) {
+		v.add(parent+"_port_upper", "must be between -1 and 65535")
+	}
+	if validPort(lower) && validPort(upper) && lower != -1 && upper != -1 && lower > upper {
+		v.add(parent+"_port_upper", "must not be lower than the lower port")
+	}
+}
+
+func (v *validator) protocolFilter(field string, filter *proto.ProtocolFilter) {
+	switch {
+	case filter.GetTcp() != nil:
+		tcp := filter.GetTcp()
+		v.filterPortRange(fieldPath(field, "tcp.src"), tcp.SrcPortLower, tcp.SrcPortUpper)
+		v.filterPortRange(fieldPath(field, "tcp.dst"), tcp.DstPortLower, tcp.DstPortUpper)
+	case filter.GetUdp() != nil:
+		udp := filter.GetUdp()
+		v.filterPortRange(fieldPath(field, "udp.src"), udp.SrcPortLower, udp.SrcPortUpper)
+		v.filterPortRange(fieldPath(field, "udp.dst"), udp.DstPortLower, udp.DstPortUpper)
+	case filter.GetIcmp() != nil:
+		icmp := filter.GetIcmp()
+		if icmp.IcmpType < -1 || icmp.IcmpType > 0xff {
+			v.add(fieldPath(field, "icmp.icmp_type"), "must be between -1 and 255")
+		}
+		if icmp.IcmpCode < -1 || icmp.IcmpCode > 0xff {
+			v.add(fieldPath(field, "icmp.icmp_code"), "must be between -1 and 255")
+		}
+	}
+}
+
+// Validate checks the spec before it is sent to dp-service.
+func (s *InterfaceSpec) Validate() error {
+	v := &validator{}
+	s.validate(v, "")
+	return v.result("InterfaceSpec")
+}
+
+func (s *InterfaceSpec) validate(v *validator, path string) {
+	switch s.Type {
+	case "", InterfaceTypeVirtual:
+	case InterfaceTypeBareMetal:
+		// Bare-metal servers use the device directly, dp-service does not assign a virtual function.
+		if s.VirtualFunction != nil {
+			v.add(fieldPath(path, "virtual_function"), "must not be set for baremetal interfaces")
+		}
+	default:
+		v.add(fieldPath(path, "type"), "must be virtual or baremetal")
+	}
+	v.vni(fieldPath(path, "vni"), s.VNI)
+	v.required(fieldPath(path, "device"), s.Device)
+	v.addr(fieldPath(path, "primary_ipv4"), s.IPv4, 4)
+	v.addr(fieldPath(path, "primary_ipv6"), s.IPv6, 6)
+}
+
+// Validate checks the interface before it is created.
+func (m *Interface) Validate() error {
+	v := &validator{}
+	v.required("metadata.id", m.ID)
+	m.Spec.validate(v, "spec")
+	return v.result(InterfaceKind)
+}
+
+// Validate checks the prefix before it is created.
+func (m *Prefix) Validate() error {
+	v := &validator{}
+	v.required("metadata.interface_id", m.InterfaceID)
+	v.prefix("spec.prefix", &m.Spec.Prefix)
+	return v.result(PrefixKind)
+}
+
+// Validate checks the load balancer prefix before it is created.
+func (m *LoadBalancerPrefix) Validate() error {
+	v := &validator{}
+	v.required("metadata.interface_id", m.InterfaceID)
+	v.prefix("spec.prefix", &m.Spec.Prefix)
+	return v.result(LoadBalancerPrefixKind)
+}
+
+// Validate checks the virtual IP before it is created.
+func (m *VirtualIP) Validate() error {
+	v := &validator{}
+	v.required("metadata.interface_id", m.InterfaceID)
+	v.addr("spec.vip_ip", m.Spec.IP, 0)
+	return v.result(VirtualIPKind)
+}
+
+// Validate checks the spec before it is sent to dp-service.
+func (s *RouteSpec) Validate() error {
+	v := &validator{}
+	s.validate(v, "")
+	return v.result("RouteSpec")
+}
+
+func (s *RouteSpec) validate(v *validator, path string) {
+	v.prefix(fieldPath(path, "prefix"), s.Prefix)
+	if s.NextHop == nil {
+		v.add(fieldPath(path, "next_hop"), "is required")
+		return
+	}
+	v.vni(fieldPath(path, "next_hop.vni"), s.NextHop.VNI)
+	v.addr(fieldPath(path, "next_hop.address"), s.NextHop.IP, 6)
+}
+
+// Validate checks the route before it is created.
+func (m *Route) Validate() error {
+	v := &validator{}
+	v.vni("metadata.vni", m.VNI)
+	m.Spec.validate(v, "spec")
+	return v.result(RouteKind)
+}
+
+// Validate checks the spec before it is sent to dp-service.
+func (s *NatSpec) Validate() error {
+	v := &validator{}
+	s.validate(v, "")
+	return v.result("NatSpec")
+}
+
+func (s *NatSpec) validate(v *validator, path string) {
+	v.addr(fieldPath(path, "nat_ip"), s.NatIP, 4)
+	v.natPortRange(path, s.MinPort, s.MaxPort)
+}
+
+// Validate checks the NAT before it is created.
+func (m *Nat) Validate() error {
+	v := &validator{}
+	v.required("metadata.interface_id", m.InterfaceID)
+	m.Spec.validate(v, "spec")
+	return v.result(NatKind)
+}
+
+// Validate checks the spec before it is sent to dp-service.
+func (s *NeighborNatSpec) Validate() error {
+	v := &validator{}
+	s.validate(v, "")
+	return v.result("NeighborNatSpec")
+}
+
+func (s *NeighborNatSpec) validate(v *validator, path string) {
+	v.vni(fieldPath(path, "vni"), s.Vni)
+	v.natPortRange(path, s.MinPort, s.MaxPort)
+	v.addr(fieldPath(path, "underlay_route"), s.UnderlayRoute, 6)
+}
+
+// Validate checks the neighbor NAT before it is created.
+func (m *NeighborNat) Validate() error {
+	v := &validator{}
+	v.addr("metadata.nat_ip", m.NatIP, 4)
+	m.Spec.validate(v, "spec")
+	return v.result(NeighborNatKind)
+}
+
+// Validate checks the spec before it is sent to dp-service.
+func (s *LoadBalancerSpec) Validate() error {
+	v := &validator{}
+	s.validate(v, "")
+	return v.result("LoadBalancerSpec")
+}
+
+func (s *LoadBalancerSpec) validate(v *validator, path string) {
+	v.vni(fieldPath(path, "vni"), s.VNI)
+	v.addr(fieldPath(path, "loadbalanced_ip"), s.LbVipIP, 0)
+	for i, port := range s.Lbports {
+		portPath := fmt.Sprintf("%s[%d]", fieldPath(path, "loadbalanced_ports"), i)
+		switch proto.Protocol(port.Protocol) {
+		case proto.Protocol_TCP, proto.Protocol_UDP:
+		default:
+			v.add(portPath+".protocol", "must be TCP (6) or UDP (17)")
+		}
+		if port.Port > 0xffff {
+			v.add(portPath+".port", "must be at most 65535")
+		}
+	}
+}
+
+// Validate checks the load balancer before it is created.
+func (m *LoadBalancer) Validate() error {
+	v := &validator{}
+	v.required("metadata.id", m.ID)
+	m.Spec.validate(v, "spec")
+	return v.result(LoadBalancerKind)
+}
+
+// Validate checks the load balancer target before it is created.
+func (m *LoadBalancerTarget) Validate() error {
+	v := &validator{}
+	v.required("metadata.loadbalancer_id", m.LoadbalancerID)
+	v.addr("spec.target_ip", m.Spec.TargetIP, 6)
+	return v.result(LoadBalancerTargetKind)
+}
+
+// Validate checks the spec before it is sent to dp-service.
+// Direction and action accept the same values as client.CreateFirewallRule.
+func (s *FirewallRuleSpec) Validate() error {
+	v := &validator{}
+	s.validate(v, "")
+	return v.result("FirewallRuleSpec")
+}
+
+func (s *FirewallRuleSpec) validate(v *validator, path string) {
+	v.required(fieldPath(path, "id"), s.RuleID)
+	if _, ok := normalizeTrafficDirection(s.TrafficDirection); !ok {
+		v.add(fieldPath(path, "direction"), "must be Ingress or Egress")
+	}
+	if _, ok := normalizeFirewallAction(s.FirewallAction); !ok {
+		v.add(fieldPath(path, "action"), "must be Accept or Drop")
+	}
+	v.prefix(fieldPath(path, "source_prefix"), s.SourcePrefix)
+	v.prefix(fieldPath(path, "destination_prefix"), s.DestinationPrefix)
+	v.protocolFilter(fieldPath(path, "protocol_filter"), s.ProtocolFilter)
+}
+
+// Validate checks the firewall rule before it is created.
+func (m *FirewallRule) Validate() error {
+	v := &validator{}
+	v.required("metadata.interface_id", m.InterfaceID)
+	m.Spec.validate(v, "spec")
+	return v.result(FirewallRuleKind)
+}
+
+// Validate checks the captured interfaces: the type has to be pf or vf, a pf is
+// identified by its numeric index and a vf by its name.
+func (s *CaptureStartSpec) Validate() error {
+	v := &validator{}
+	s.validate(v, "")
+	return v.result("CaptureStartSpec")
+}
+
+func (s *CaptureStartSpec) validate(v *validator, path string) {
+	for i := range s.Interfaces {
+		s.Interfaces[i].validate(v, fmt.Sprintf("%s[%d]", fieldPath(path, "interfaces"), i))
+	}
+}
+
+// Validate checks a single captured interface, see CaptureStartSpec.Validate.
+func (i *CaptureInterface) Validate() error {
+	v := &validator{}
+	i.validate(v, "")
+	return v.result("CaptureInterface")
+}
+
+func (i *CaptureInterface) validate(v *validator, path string) {
+	switch i.InterfaceType {
+	case "pf":
+		if _, err := strconv.ParseUint(i.InterfaceInfo, 10, 32); err != nil {
+			v.add(fieldPath(path, "interface_info"), "must be a pf index")
+		}
+	case "vf":
+		v.required(fieldPath(path, "interface_info"), i.InterfaceInfo)
+	default:
+		v.add(fieldPath(path, "interface_type"), "must be pf or vf")
+	}
+}
+
+// Validate checks the capture configuration. The captured interfaces in Spec
+// are validated separately by CaptureStartSpec.Validate.
+func (m *CaptureStart) Validate() error {
+	v := &validator{}
+	if m.Config == nil {
+		v.add("metadata.capture_config", "is required")
+		return v.result(CaptureStartKind)
+	}
+	v.addr("metadata.capture_config.sink_node_ipv6", m.Config.SinkNodeIP, 6)
+	if m.Config.UdpSrcPort > 0xffff {
+		v.add("metadata.capture_config.udp_src_port", "must be at most 65535")
+	}
+	if m.Config.UdpDstPort > 0xffff {
+		v.add("metadata.capture_config.udp_dst_port", "must be at most 65535")
+	}
+	return v.result(CaptureStartKind)
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+)
+
+// appliedFirst reports whether obj is of a type other objects depend on.
+func appliedFirst(obj api.Object) bool {
+	switch obj.(type) {
+	case *api.Interface, *api.LoadBalancer:
+		return true
+	default:
+		return false
+	}
+}
+
+// Apply creates objs, e.g. decoded with api.DecodeAll, by calling the Create method matching their type.
+// Interfaces and load balancers are created first, everything else keeps the order of objs.
+// Apply stops at the first error and returns the objects created until then.
+func Apply(ctx context.Context, c Client, objs []api.Object, opts ...CallOption) ([]api.Object, error) {
+	ordered := make([]api.Object, len(objs))
+	copy(ordered, objs)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return appliedFirst(ordered[i]) && !appliedFirst(ordered[j])
+	})
+
+	created := make([]api.Object, 0, len(ordered))
+	for _, obj := range ordered {
+		res, err := create(ctx, c, obj, opts...)
+		if err != nil {
+			return created, fmt.Errorf("error applying %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		created = append(created, res)
+	}
+	return created, nil
+}
+
+func create(ctx context.Context, c Client, obj api.Object, opts ...CallOption) (api.Object, error) {
+	switch obj := obj.(type) {
+	case *api.Interface:
+		return c.CreateInterface(ctx, obj, opts...)
+	case *api.Prefix:
+		return c.CreatePrefix(ctx, obj, opts...)
+	case *api.LoadBalancerPrefix:
+		return c.CreateLoadBalancerPrefix(ctx, obj, opts...)
+	case *api.VirtualIP:
+		return c.CreateVirtualIP(ctx, obj, opts...)
+	case *api.LoadBalancer:
+		return c.CreateLoadBalancer(ctx, obj, opts...)
+	case *api.LoadBalancerTarget:
+		return c.CreateLoadBalancerTarget(ctx, obj, opts...)
+	case *api.Nat:
+		return c.CreateNat(ctx, obj, opts...)
+	case *api.NeighborNat:
+		return c.CreateNeighborNat(ctx, obj, opts...)
+	case *api.Route:
+		return c.CreateRoute(ctx, obj, opts...)
+	case *api.FirewallRule:
+		return c.CreateFirewallRule(ctx, obj, opts...)
+	default:
+		return nil, fmt.Errorf("cannot create objects of type %T", obj)
+	}
+}
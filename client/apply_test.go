@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"net/netip"
+	"strings"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const manifest = `
+kind: Route
+metadata:
+  vni: 100
+spec:
+  prefix: 10.100.3.0/24
+  next_hop:
+    vni: 0
+    address: fc00:2::64:0:1
+---
+# the interface is created before the objects depending on it
+kind: Interface
+metadata:
+  id: vm1
+spec:
+  vni: 100
+  device: net_tap2
+  primary_ipv4: 10.200.1.4
+  primary_ipv6: 2000:200:1::4
+---
+kind: FirewallRule
+metadata:
+  interface_id: vm1
+spec:
+  id: fr1
+  direction: Ingress
+  action: Accept
+  priority: 1000
+  source_prefix: 0.0.0.0/0
+  destination_prefix: 10.200.1.4/32
+  protocol_filter:
+    tcp:
+      src_port_lower: -1
+      src_port_upper: -1
+      dst_port_lower: 443
+      dst_port_upper: 443
+---
+{"kind": "Prefix", "metadata": {"interface_id": "vm1"}, "spec": {"prefix": "10.20.30.0/24"}}
+---
+`
+
+var _ = Describe("apply", Label("apply"), func() {
+	var c Client
+
+	BeforeEach(func(ctx SpecContext) {
+		c = newFakeClient(ctx)
+	})
+
+	It("should decode a manifest and create its objects", func(ctx SpecContext) {
+		objs, err := api.DecodeAll(strings.NewReader(manifest))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objs).To(HaveLen(4))
+		Expect(objs[0]).To(BeAssignableToTypeOf(&api.Route{}))
+		Expect(objs[2].(*api.FirewallRule).Spec.ProtocolFilter.GetTcp().GetDstPortLower()).To(Equal(int32(443)))
+
+		created, err := Apply(ctx, c, objs)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(created).To(HaveLen(4))
+		Expect(created[0]).To(BeAssignableToTypeOf(&api.Interface{}))
+
+		routes, err := c.ListRoutes(ctx, 100)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(routes.Items).To(HaveLen(1))
+		rules, err := c.ListFirewallRules(ctx, "vm1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rules.Items).To(HaveLen(1))
+	})
+
+	It("should flatten lists", func() {
+		objs, err := api.Decode([]byte(`{"kind": "PrefixList", "metadata": {"interface_id": "vm1"}, "items": [
+			{"kind": "Prefix", "metadata": {"interface_id": "vm1"}, "spec": {"prefix": "10.20.30.0/24"}},
+			{"kind": "Prefix", "metadata": {"interface_id": "vm1"}, "spec": {"prefix": "10.20.31.0/24"}}
+		]}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objs).To(HaveLen(2))
+	})
+
+	It("should reject unknown kinds and fields", func() {
+		_, err := api.DecodeAll(strings.NewReader("kind: Interface\nmetadata:\n  id: vm1\n---\nkind: Foo\n"))
+		Expect(err).To(MatchError(`document 2: unknown kind "Foo"`))
+
+		_, err = api.Decode([]byte("kind: Interface\nspec:\n  vni: 100\n  devcie: net_tap2\n"))
+		Expect(err).To(MatchError(ContainSubstring(`unknown field "devcie"`)))
+	})
+
+	It("should stop at the first failing object", func(ctx SpecContext) {
+		objs, err := api.DecodeAll(strings.NewReader(manifest))
+		Expect(err).NotTo(HaveOccurred())
+
+		created, err := Apply(ctx, c, objs[2:])
+		Expect(err).To(MatchError(ContainSubstring("error applying FirewallRule vm1/fr1")))
+		Expect(created).To(BeEmpty())
+	})
+	It("should order objects without kind by their type", func(ctx SpecContext) {
+		objs := []api.Object{
+			&api.Prefix{
+				PrefixMeta: api.PrefixMeta{InterfaceID: "vm1"},
+				Spec:       api.PrefixSpec{Prefix: netip.MustParsePrefix("10.20.30.0/24")},
+			},
+			&api.Interface{
+				InterfaceMeta: api.InterfaceMeta{ID: "vm1"},
+				Spec:          api.InterfaceSpec{VNI: 100, Device: "net_tap2", IPv4: ptrAddr("10.200.1.4"), IPv6: ptrAddr("2000:200:1::4")},
+			},
+		}
+
+		created, err := Apply(ctx, c, objs)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(created).To(HaveLen(2))
+		Expect(created[0]).To(BeAssignableToTypeOf(&api.Interface{}))
+		Expect(created[1]).To(BeAssignableToTypeOf(&api.Prefix{}))
+	})
+})
@@ -0,0 +1,350 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/netip"
+	"sync"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/errors"
+)
+
+// DefaultBatchWorkers is the number of concurrent calls of a batch if WithWorkers is not set.
+const DefaultBatchWorkers = 8
+
+// ErrBatchSkipped is the error of items not attempted because an earlier item failed and WithRollback is set.
+var ErrBatchSkipped = stderrors.New("skipped because another item failed")
+
+type batchOptions struct {
+	workers     int
+	rollback    bool
+	callOptions []CallOption
+}
+
+// BatchOption configures the batch functions.
+type BatchOption func(*batchOptions)
+
+// WithWorkers sets the number of concurrent calls.
+func WithWorkers(workers int) BatchOption {
+	return func(o *batchOptions) {
+		o.workers = workers
+	}
+}
+
+// WithRollback undoes the items applied successfully if any item fails. Items whose status code was
+// ignored with IgnoreErrors are not undone. Remaining items are not attempted after the first failure.
+func WithRollback() BatchOption {
+	return func(o *batchOptions) {
+		o.rollback = true
+	}
+}
+
+// WithBatchCallOptions passes opts to every call of the batch.
+func WithBatchCallOptions(opts ...CallOption) BatchOption {
+	return func(o *batchOptions) {
+		o.callOptions = append(o.callOptions, opts...)
+	}
+}
+
+func newBatchOptions(opts []BatchOption) *batchOptions {
+	o := &batchOptions{workers: DefaultBatchWorkers}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.workers < 1 {
+		o.workers = 1
+	}
+	return o
+}
+
+// BatchResult holds the result of every item of a batch, in the order of the input.
+type BatchResult[T api.Object] struct {
+	// Items are the objects returned by dp-service, nil if the item failed.
+	Items []T
+	// Errors are the errors of the items, nil if the item succeeded.
+	Errors []error
+	// RolledBack is set if the successful items were undone after a failure.
+	RolledBack bool
+	// RollbackErrors are the errors of the items which could not be undone.
+	RollbackErrors []error
+}
+
+// Failed returns the indexes of the failed items, not including skipped ones.
+func (r *BatchResult[T]) Failed() []int {
+	var failed []int
+	for i, err := range r.Errors {
+		if err != nil && err != ErrBatchSkipped {
+			failed = append(failed, i)
+		}
+	}
+	return failed
+}
+
+// Err combines the errors of the failed items and of the rollback, it is nil if all items succeeded.
+func (r *BatchResult[T]) Err() error {
+	var errs []error
+	for _, i := range r.Failed() {
+		errs = append(errs, fmt.Errorf("item %d: %w", i, r.Errors[i]))
+	}
+	for _, err := range r.RollbackErrors {
+		errs = append(errs, fmt.Errorf("rollback: %w", err))
+	}
+	return stderrors.Join(errs...)
+}
+
+// runBatch calls do for every item with o.workers concurrent calls and,
+// if o.rollback is set and an item fails, undo for every successful item.
+func runBatch[T api.Object](ctx context.Context, items []T, o *batchOptions, do func(context.Context, T) (T, error), undo func(context.Context, T) error) *BatchResult[T] {
+	res := &BatchResult[T]{
+		Items:  make([]T, len(items)),
+		Errors: make([]error, len(items)),
+	}
+
+	var (
+		mu     sync.Mutex
+		failed bool
+		wg     sync.WaitGroup
+	)
+	indexes := make(chan int)
+	for w := 0; w < o.workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				mu.Lock()
+				skip := failed && o.rollback
+				mu.Unlock()
+				if skip {
+					res.Errors[i] = ErrBatchSkipped
+					continue
+				}
+
+				item, err := do(ctx, items[i])
+				if err != nil {
+					res.Errors[i] = err
+					mu.Lock()
+					failed = true
+					mu.Unlock()
+					continue
+				}
+				res.Items[i] = item
+			}
+		}()
+	}
+	for i := range items {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	if !failed || !o.rollback {
+		return res
+	}
+
+	// Undo even if ctx was canceled, otherwise the batch stays half applied.
+	undoCtx := context.WithoutCancel(ctx)
+	for i := range items {
+		// Items whose status code was ignored, e.g. routes that existed before, were not changed by the batch.
+		if res.Errors[i] != nil || res.Items[i].GetStatus().Code != 0 {
+			continue
+		}
+		if err := undo(undoCtx, items[i]); err != nil {
+			res.RollbackErrors = append(res.RollbackErrors, fmt.Errorf("item %d: %w", i, err))
+		}
+	}
+	res.RolledBack = true
+	return res
+}
+
+func pointers[T any](items []T) []*T {
+	ptrs := make([]*T, len(items))
+	for i := range items {
+		ptrs[i] = &items[i]
+	}
+	return ptrs
+}
+
+// CreateRoutes creates routes concurrently. The returned error is BatchResult.Err.
+func CreateRoutes(ctx context.Context, c Client, routes []api.Route, opts ...BatchOption) (*BatchResult[*api.Route], error) {
+	o := newBatchOptions(opts)
+	res := runBatch(ctx, pointers(routes), o,
+		func(ctx context.Context, route *api.Route) (*api.Route, error) {
+			return c.CreateRoute(ctx, route, o.callOptions...)
+		},
+		func(ctx context.Context, route *api.Route) error {
+			_, err := c.DeleteRoute(ctx, route.VNI, route.Spec.Prefix, o.callOptions...)
+			return err
+		},
+	)
+	return res, res.Err()
+}
+
+// DeleteRoutes deletes routes concurrently, the rollback creates them again. Routes only need their
+// VNI and prefix, with WithRollback the routes of their VNIs are listed first so the rollback can
+// restore the next hops. Nothing is deleted if that fails.
+func DeleteRoutes(ctx context.Context, c Client, routes []api.Route, opts ...BatchOption) (*BatchResult[*api.Route], error) {
+	o := newBatchOptions(opts)
+	var existing map[routeKey]api.Route
+	if o.rollback {
+		var res *BatchResult[*api.Route]
+		if existing, res = listRoutesOf(ctx, c, routes, o); res != nil {
+			return res, res.Err()
+		}
+	}
+	res := runBatch(ctx, pointers(routes), o,
+		func(ctx context.Context, route *api.Route) (*api.Route, error) {
+			return c.DeleteRoute(ctx, route.VNI, route.Spec.Prefix, o.callOptions...)
+		},
+		func(ctx context.Context, route *api.Route) error {
+			if full, ok := existing[newRouteKey(route)]; ok {
+				route = &full
+			}
+			_, err := c.CreateRoute(ctx, route, o.callOptions...)
+			return err
+		},
+	)
+	return res, res.Err()
+}
+
+type routeKey struct {
+	vni    uint32
+	prefix netip.Prefix
+}
+
+func newRouteKey(route *api.Route) routeKey {
+	key := routeKey{vni: route.VNI}
+	if route.Spec.Prefix != nil {
+		key.prefix = route.Spec.Prefix.Masked()
+	}
+	return key
+}
+
+// listRoutesOf lists the routes of the VNIs of routes. If a list fails, it returns a result
+// failing the items of that VNI and skipping the others.
+func listRoutesOf(ctx context.Context, c Client, routes []api.Route, o *batchOptions) (map[routeKey]api.Route, *BatchResult[*api.Route]) {
+	existing := make(map[routeKey]api.Route)
+	listed := make(map[uint32]bool)
+	for _, route := range routes {
+		if listed[route.VNI] {
+			continue
+		}
+		listed[route.VNI] = true
+
+		list, err := c.ListRoutes(ctx, route.VNI, o.callOptions...)
+		if err == nil && list.Status.Code == errors.NO_VNI {
+			continue
+		}
+		if list, err = checkList(list, err); err != nil {
+			res := &BatchResult[*api.Route]{
+				Items:  make([]*api.Route, len(routes)),
+				Errors: make([]error, len(routes)),
+			}
+			for i := range routes {
+				res.Errors[i] = ErrBatchSkipped
+				if routes[i].VNI == route.VNI {
+					res.Errors[i] = fmt.Errorf("listing the routes of VNI %d: %w", route.VNI, err)
+				}
+			}
+			return nil, res
+		}
+		for _, item := range list.Items {
+			existing[newRouteKey(&item)] = item
+		}
+	}
+	return existing, nil
+}
+
+// CreatePrefixes creates prefixes concurrently.
+func CreatePrefixes(ctx context.Context, c Client, prefixes []api.Prefix, opts ...BatchOption) (*BatchResult[*api.Prefix], error) {
+	o := newBatchOptions(opts)
+	res := runBatch(ctx, pointers(prefixes), o,
+		func(ctx context.Context, prefix *api.Prefix) (*api.Prefix, error) {
+			return c.CreatePrefix(ctx, prefix, o.callOptions...)
+		},
+		func(ctx context.Context, prefix *api.Prefix) error {
+			_, err := c.DeletePrefix(ctx, prefix.InterfaceID, &prefix.Spec.Prefix, o.callOptions...)
+			return err
+		},
+	)
+	return res, res.Err()
+}
+
+// DeletePrefixes deletes prefixes concurrently, the rollback creates them again.
+func DeletePrefixes(ctx context.Context, c Client, prefixes []api.Prefix, opts ...BatchOption) (*BatchResult[*api.Prefix], error) {
+	o := newBatchOptions(opts)
+	res := runBatch(ctx, pointers(prefixes), o,
+		func(ctx context.Context, prefix *api.Prefix) (*api.Prefix, error) {
+			return c.DeletePrefix(ctx, prefix.InterfaceID, &prefix.Spec.Prefix, o.callOptions...)
+		},
+		func(ctx context.Context, prefix *api.Prefix) error {
+			_, err := c.CreatePrefix(ctx, prefix, o.callOptions...)
+			return err
+		},
+	)
+	return res, res.Err()
+}
+
+// CreateFirewallRules creates rules concurrently.
+func CreateFirewallRules(ctx context.Context, c Client, rules []api.FirewallRule, opts ...BatchOption) (*BatchResult[*api.FirewallRule], error) {
+	o := newBatchOptions(opts)
+	res := runBatch(ctx, pointers(rules), o,
+		func(ctx context.Context, rule *api.FirewallRule) (*api.FirewallRule, error) {
+			return c.CreateFirewallRule(ctx, rule, o.callOptions...)
+		},
+		func(ctx context.Context, rule *api.FirewallRule) error {
+			_, err := c.DeleteFirewallRule(ctx, rule.InterfaceID, rule.Spec.RuleID, o.callOptions...)
+			return err
+		},
+	)
+	return res, res.Err()
+}
+
+// DeleteFirewallRules deletes rules concurrently, the rollback creates them again.
+func DeleteFirewallRules(ctx context.Context, c Client, rules []api.FirewallRule, opts ...BatchOption) (*BatchResult[*api.FirewallRule], error) {
+	o := newBatchOptions(opts)
+	res := runBatch(ctx, pointers(rules), o,
+		func(ctx context.Context, rule *api.FirewallRule) (*api.FirewallRule, error) {
+			return c.DeleteFirewallRule(ctx, rule.InterfaceID, rule.Spec.RuleID, o.callOptions...)
+		},
+		func(ctx context.Context, rule *api.FirewallRule) error {
+			_, err := c.CreateFirewallRule(ctx, rule, o.callOptions...)
+			return err
+		},
+	)
+	return res, res.Err()
+}
+
+// CreateLoadBalancerTargets creates targets concurrently.
+func CreateLoadBalancerTargets(ctx context.Context, c Client, targets []api.LoadBalancerTarget, opts ...BatchOption) (*BatchResult[*api.LoadBalancerTarget], error) {
+	o := newBatchOptions(opts)
+	res := runBatch(ctx, pointers(targets), o,
+		func(ctx context.Context, target *api.LoadBalancerTarget) (*api.LoadBalancerTarget, error) {
+			return c.CreateLoadBalancerTarget(ctx, target, o.callOptions...)
+		},
+		func(ctx context.Context, target *api.LoadBalancerTarget) error {
+			_, err := c.DeleteLoadBalancerTarget(ctx, target.LoadbalancerID, target.Spec.TargetIP, o.callOptions...)
+			return err
+		},
+	)
+	return res, res.Err()
+}
+
+// DeleteLoadBalancerTargets deletes targets concurrently, the rollback creates them again.
+func DeleteLoadBalancerTargets(ctx context.Context, c Client, targets []api.LoadBalancerTarget, opts ...BatchOption) (*BatchResult[*api.LoadBalancerTarget], error) {
+	o := newBatchOptions(opts)
+	res := runBatch(ctx, pointers(targets), o,
+		func(ctx context.Context, target *api.LoadBalancerTarget) (*api.LoadBalancerTarget, error) {
+			return c.DeleteLoadBalancerTarget(ctx, target.LoadbalancerID, target.Spec.TargetIP, o.callOptions...)
+		},
+		func(ctx context.Context, target *api.LoadBalancerTarget) error {
+			_, err := c.CreateLoadBalancerTarget(ctx, target, o.callOptions...)
+			return err
+		},
+	)
+	return res, res.Err()
+}
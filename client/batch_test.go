@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/errors"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("batch", Label("batch"), func() {
+	var c Client
+
+	BeforeEach(func(ctx SpecContext) {
+		c = newFakeClient(ctx)
+
+		_, err := c.CreateInterface(ctx, &api.Interface{
+			InterfaceMeta: api.InterfaceMeta{ID: "vm5"},
+			Spec: api.InterfaceSpec{
+				VNI:    500,
+				Device: "net_tap6",
+				IPv4:   ptrAddr("10.200.5.4"),
+				IPv6:   ptrAddr("2000:200:5::4"),
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	newRoute := func(i int) api.Route {
+		prefix := netip.MustParsePrefix(fmt.Sprintf("10.50.%d.0/24", i))
+		return api.Route{
+			RouteMeta: api.RouteMeta{VNI: 500},
+			Spec: api.RouteSpec{
+				Prefix:  &prefix,
+				NextHop: &api.RouteNextHop{IP: ptrAddr("fc00:5::64:0:1")},
+			},
+		}
+	}
+
+	It("should create and delete routes concurrently", func(ctx SpecContext) {
+		routes := make([]api.Route, 20)
+		for i := range routes {
+			routes[i] = newRoute(i)
+		}
+
+		res, err := CreateRoutes(ctx, c, routes, WithWorkers(4))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Items).To(HaveLen(20))
+		Expect(res.Items[7].Spec.Prefix.String()).To(Equal("10.50.7.0/24"))
+		Expect(res.Failed()).To(BeEmpty())
+
+		list, err := c.ListRoutes(ctx, 500)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Items).To(HaveLen(20))
+
+		_, err = DeleteRoutes(ctx, c, routes)
+		Expect(err).NotTo(HaveOccurred())
+		list, err = c.ListRoutes(ctx, 500)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Items).To(BeEmpty())
+	})
+
+	It("should keep the results of the other items if one fails", func(ctx SpecContext) {
+		routes := []api.Route{newRoute(1), newRoute(2), {RouteMeta: api.RouteMeta{VNI: 500}}}
+
+		res, err := CreateRoutes(ctx, c, routes)
+		Expect(err).To(MatchError(ContainSubstring("item 2: invalid Route")))
+		Expect(res.Failed()).To(Equal([]int{2}))
+		Expect(res.Items[0]).NotTo(BeNil())
+		Expect(res.Items[1]).NotTo(BeNil())
+		Expect(res.RolledBack).To(BeFalse())
+
+		list, err := c.ListRoutes(ctx, 500)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Items).To(HaveLen(2))
+	})
+
+	It("should roll back applied items", func(ctx SpecContext) {
+		routes := []api.Route{newRoute(1), newRoute(2), newRoute(1), newRoute(3)}
+
+		res, err := CreateRoutes(ctx, c, routes, WithWorkers(1), WithRollback())
+		Expect(errors.IsStatusErrorCode(err, errors.ROUTE_EXISTS)).To(BeTrue())
+		Expect(res.Failed()).To(Equal([]int{2}))
+		Expect(res.Errors[3]).To(Equal(ErrBatchSkipped))
+		Expect(res.RolledBack).To(BeTrue())
+		Expect(res.RollbackErrors).To(BeEmpty())
+
+		list, err := c.ListRoutes(ctx, 500)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Items).To(BeEmpty())
+	})
+
+	It("should not roll back items whose status code was ignored", func(ctx SpecContext) {
+		existing := newRoute(1)
+		_, err := c.CreateRoute(ctx, &existing)
+		Expect(err).NotTo(HaveOccurred())
+
+		routes := []api.Route{newRoute(1), newRoute(2), {RouteMeta: api.RouteMeta{VNI: 500}}}
+		res, err := CreateRoutes(ctx, c, routes, WithWorkers(1), WithRollback(),
+			WithBatchCallOptions(IgnoreErrors(errors.ROUTE_EXISTS)))
+		Expect(err).To(HaveOccurred())
+		Expect(res.Items[0].Status.Code).To(Equal(uint32(errors.ROUTE_EXISTS)))
+		Expect(res.RolledBack).To(BeTrue())
+		Expect(res.RollbackErrors).To(BeEmpty())
+
+		list, err := c.ListRoutes(ctx, 500)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Items).To(HaveLen(1))
+		Expect(list.Items[0].Spec.Prefix.String()).To(Equal("10.50.1.0/24"))
+	})
+
+	It("should restore the next hops of deleted routes on rollback", func(ctx SpecContext) {
+		_, err := CreateRoutes(ctx, c, []api.Route{newRoute(1), newRoute(2)})
+		Expect(err).NotTo(HaveOccurred())
+
+		routes := []api.Route{newRoute(1), newRoute(3)}
+		for i := range routes {
+			routes[i].Spec.NextHop = nil
+		}
+		res, err := DeleteRoutes(ctx, c, routes, WithWorkers(1), WithRollback())
+		Expect(err).To(HaveOccurred())
+		Expect(res.Failed()).To(Equal([]int{1}))
+		Expect(res.RolledBack).To(BeTrue())
+		Expect(res.RollbackErrors).To(BeEmpty())
+
+		list, err := c.ListRoutes(ctx, 500)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Items).To(HaveLen(2))
+		for _, route := range list.Items {
+			Expect(route.Spec.NextHop.IP.String()).To(Equal("fc00:5::64:0:1"))
+		}
+	})
+
+	It("should create firewall rules", func(ctx SpecContext) {
+		anyPrefix := netip.MustParsePrefix("0.0.0.0/0")
+		rules := make([]api.FirewallRule, 5)
+		for i := range rules {
+			rules[i] = api.FirewallRule{
+				FirewallRuleMeta: api.FirewallRuleMeta{InterfaceID: "vm5"},
+				Spec: api.FirewallRuleSpec{
+					RuleID:            fmt.Sprintf("fr%d", i),
+					TrafficDirection:  "ingress",
+					FirewallAction:    "accept",
+					Priority:          uint32(1000 + i),
+					SourcePrefix:      &anyPrefix,
+					DestinationPrefix: &anyPrefix,
+				},
+			}
+		}
+
+		_, err := CreateFirewallRules(ctx, c, rules, WithBatchCallOptions(IgnoreErrors(errors.ALREADY_EXISTS)))
+		Expect(err).NotTo(HaveOccurred())
+
+		list, err := c.ListFirewallRules(ctx, "vm5")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Items).To(HaveLen(5))
+	})
+})
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/errors"
+	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
+	"google.golang.org/grpc"
+)
+
+// CallOption configures a single call to dp-service.
+type CallOption func(*callOptions)
+
+type callOptions struct {
+	ignoredErrors  []uint32
+	timeout        time.Duration
+	grpcOptions    []grpc.CallOption
+	details        bool
+	lenientCapture bool
+}
+
+// IgnoreErrors makes the call succeed if dp-service returns one of the status codes.
+// It can be passed multiple times, all codes are ignored.
+func IgnoreErrors(codes ...uint32) CallOption {
+	return func(o *callOptions) {
+		o.ignoredErrors = append(o.ignoredErrors, codes...)
+	}
+}
+
+// WithTimeout limits the duration of the call, in addition to the deadline of the context.
+func WithTimeout(timeout time.Duration) CallOption {
+	return func(o *callOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithCallOptions passes gRPC call options to the underlying call.
+func WithCallOptions(opts ...grpc.CallOption) CallOption {
+	return func(o *callOptions) {
+		o.grpcOptions = append(o.grpcOptions, opts...)
+	}
+}
+
+// WithDetails makes GetInterface and ListInterfaces fill in the NAT, virtual IP, prefixes
+// and firewall rules of the interfaces. Other methods ignore it.
+// The virtual function stays nil, dp-service only reports it when the interface is created.
+// Spec.Device is the PCI name of the device the interface is bound to.
+func WithDetails() CallOption {
+	return func(o *callOptions) {
+		o.details = true
+	}
+}
+
+// WithLenientCapture makes CaptureStart skip invalid captured interfaces instead of failing,
+// the skipped ones are returned in Status.SkippedInterfaces. CaptureStart still fails if no
+// valid interface is left. Other methods ignore it.
+func WithLenientCapture() CallOption {
+	return func(o *callOptions) {
+		o.lenientCapture = true
+	}
+}
+
+func newCallOptions(opts []CallOption) *callOptions {
+	o := &callOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+func (o *callOptions) context(ctx context.Context) (context.Context, context.CancelFunc) {
+	if o.timeout > 0 {
+		return context.WithTimeout(ctx, o.timeout)
+	}
+	return ctx, func() {}
+}
+
+// getError returns the StatusError for a non-zero status unless its code is ignored.
+func (o *callOptions) getError(status *dpdkproto.Status) error {
+	return errors.GetError(status, [][]uint32{o.ignoredErrors})
+}
+
+// checkList turns a non-zero status of a list call into a StatusError. The List methods return
+// the list without an error in that case, callers that must not mistake a failure for an empty
+// list check it with checkList.
+func checkList[T interface{ GetStatus() api.Status }](list T, err error) (T, error) {
+	if err != nil {
+		return list, err
+	}
+	if status := list.GetStatus(); status.Code != 0 {
+		return list, errors.NewStatusError(status.Code, status.Message)
+	}
+	return list, nil
+}
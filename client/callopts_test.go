@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/ironcore-dev/dpservice-go/errors"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+)
+
+type markerCallOption struct {
+	grpc.EmptyCallOption
+}
+
+var _ = Describe("call options", Label("callopts"), func() {
+	var c Client
+	var deadlines []bool
+	var marked []bool
+
+	BeforeEach(func(ctx SpecContext) {
+		deadlines, marked = nil, nil
+		c = newFakeClient(ctx, grpc.WithUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			_, ok := ctx.Deadline()
+			deadlines = append(deadlines, ok)
+			hasMarker := false
+			for _, opt := range opts {
+				if _, ok := opt.(markerCallOption); ok {
+					hasMarker = true
+				}
+			}
+			marked = append(marked, hasMarker)
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}))
+	})
+
+	It("should combine ignored errors", func(ctx SpecContext) {
+		_, err := c.GetInterface(ctx, "vm1", IgnoreErrors(errors.NOT_FOUND))
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = c.DeleteLoadBalancer(ctx, "lb1", IgnoreErrors(errors.NOT_FOUND), IgnoreErrors(errors.NO_LB))
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = c.GetInterface(ctx, "vm1", IgnoreErrors(errors.NO_VM))
+		Expect(errors.IsStatusErrorCode(err, errors.NOT_FOUND)).To(BeTrue())
+	})
+
+	It("should pass the timeout and gRPC call options", func(ctx SpecContext) {
+		_, err := c.CheckInitialized(context.Background(), WithTimeout(time.Second), WithCallOptions(markerCallOption{}))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.CheckInitialized(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(deadlines).To(Equal([]bool{false, true, false}))
+		Expect(marked).To(Equal([]bool{false, true, false}))
+	})
+
+	It("should keep the old signatures in the legacy client", func(ctx SpecContext) {
+		legacy := NewLegacyClient(c)
+		_, err := legacy.DeleteInterface(ctx, "vm1", errors.Ignore(errors.NO_VM), errors.Ignore(errors.NOT_FOUND))
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = legacy.DeleteInterface(ctx, "vm1")
+		Expect(errors.IsStatusErrorCode(err, errors.NOT_FOUND)).To(BeTrue())
+	})
+})
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"github.com/ironcore-dev/dpservice-go/api"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("capture start", Label("capture"), func() {
+	var c Client
+
+	BeforeEach(func(ctx SpecContext) {
+		c = newFakeClient(ctx)
+	})
+
+	newCapture := func(interfaces ...api.CaptureInterface) *api.CaptureStart {
+		return &api.CaptureStart{
+			CaptureStartMeta: api.CaptureStartMeta{
+				Config: &api.CaptureConfig{SinkNodeIP: ptrAddr("fc00:2::64:0:1"), UdpSrcPort: 500, UdpDstPort: 1000},
+			},
+			Spec: api.CaptureStartSpec{Interfaces: interfaces},
+		}
+	}
+
+	It("should fail on invalid interfaces without starting a capture", func(ctx SpecContext) {
+		_, err := c.CaptureStart(ctx, newCapture(
+			api.CaptureInterface{InterfaceType: "pf", InterfaceInfo: "0"},
+			api.CaptureInterface{InterfaceType: "xf", InterfaceInfo: "net_tap2"},
+			api.CaptureInterface{InterfaceType: "pf", InterfaceInfo: "first"},
+		))
+		Expect(err).To(MatchError("invalid CaptureStartSpec: interfaces[1].interface_type: must be pf or vf, " +
+			"interfaces[2].interface_info: must be a pf index"))
+
+		status, err := c.CaptureStatus(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.Spec.OperationStatus).To(BeFalse())
+	})
+
+	It("should skip invalid interfaces and return them in lenient mode", func(ctx SpecContext) {
+		capture := newCapture(
+			api.CaptureInterface{InterfaceType: "pf", InterfaceInfo: "0"},
+			api.CaptureInterface{InterfaceType: "xf", InterfaceInfo: "net_tap2"},
+			api.CaptureInterface{InterfaceType: "vf"},
+		)
+		res, err := c.CaptureStart(ctx, capture, WithLenientCapture())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Spec.Interfaces).To(Equal([]api.CaptureInterface{{InterfaceType: "pf", InterfaceInfo: "0"}}))
+		Expect(res.Status.SkippedInterfaces).To(Equal([]api.CaptureInterface{
+			{InterfaceType: "xf", InterfaceInfo: "net_tap2"},
+			{InterfaceType: "vf"},
+		}))
+		Expect(capture.Spec.Interfaces).To(HaveLen(3))
+		Expect(capture.Status).To(BeZero())
+
+		status, err := c.CaptureStatus(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.Spec.OperationStatus).To(BeTrue())
+		Expect(status.Spec.Interfaces).To(Equal([]api.CaptureInterface{{InterfaceType: "pf", InterfaceInfo: "0"}}))
+	})
+
+	It("should fail in lenient mode if no valid interface is left", func(ctx SpecContext) {
+		_, err := c.CaptureStart(ctx, newCapture(
+			api.CaptureInterface{InterfaceType: "xf", InterfaceInfo: "net_tap2"},
+		), WithLenientCapture())
+		Expect(err).To(MatchError("invalid CaptureStartSpec: interfaces[0].interface_type: must be pf or vf"))
+
+		status, err := c.CaptureStatus(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.Spec.OperationStatus).To(BeFalse())
+	})
+})
@@ -6,82 +6,107 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/netip"
 	"strings"
 
 	"github.com/ironcore-dev/dpservice-go/api"
-	"github.com/ironcore-dev/dpservice-go/errors"
 	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
 )
 
 type Client interface {
-	GetLoadBalancer(ctx context.Context, id string, ignoredErrors ...[]uint32) (*api.LoadBalancer, error)
-	CreateLoadBalancer(ctx context.Context, lb *api.LoadBalancer, ignoredErrors ...[]uint32) (*api.LoadBalancer, error)
-	DeleteLoadBalancer(ctx context.Context, id string, ignoredErrors ...[]uint32) (*api.LoadBalancer, error)
-
-	ListLoadBalancerPrefixes(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.PrefixList, error)
-	CreateLoadBalancerPrefix(ctx context.Context, prefix *api.LoadBalancerPrefix, ignoredErrors ...[]uint32) (*api.LoadBalancerPrefix, error)
-	DeleteLoadBalancerPrefix(ctx context.Context, interfaceID string, prefix *netip.Prefix, ignoredErrors ...[]uint32) (*api.LoadBalancerPrefix, error)
-
-	ListLoadBalancerTargets(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.LoadBalancerTargetList, error)
-	CreateLoadBalancerTarget(ctx context.Context, lbtarget *api.LoadBalancerTarget, ignoredErrors ...[]uint32) (*api.LoadBalancerTarget, error)
-	DeleteLoadBalancerTarget(ctx context.Context, id string, targetIP *netip.Addr, ignoredErrors ...[]uint32) (*api.LoadBalancerTarget, error)
-
-	GetInterface(ctx context.Context, id string, ignoredErrors ...[]uint32) (*api.Interface, error)
-	ListInterfaces(ctx context.Context, ignoredErrors ...[]uint32) (*api.InterfaceList, error)
-	CreateInterface(ctx context.Context, iface *api.Interface, ignoredErrors ...[]uint32) (*api.Interface, error)
-	DeleteInterface(ctx context.Context, id string, ignoredErrors ...[]uint32) (*api.Interface, error)
-
-	GetVirtualIP(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.VirtualIP, error)
-	CreateVirtualIP(ctx context.Context, virtualIP *api.VirtualIP, ignoredErrors ...[]uint32) (*api.VirtualIP, error)
-	DeleteVirtualIP(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.VirtualIP, error)
-
-	ListPrefixes(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.PrefixList, error)
-	CreatePrefix(ctx context.Context, prefix *api.Prefix, ignoredErrors ...[]uint32) (*api.Prefix, error)
-	DeletePrefix(ctx context.Context, interfaceID string, prefix *netip.Prefix, ignoredErrors ...[]uint32) (*api.Prefix, error)
-
-	ListRoutes(ctx context.Context, vni uint32, ignoredErrors ...[]uint32) (*api.RouteList, error)
-	CreateRoute(ctx context.Context, route *api.Route, ignoredErrors ...[]uint32) (*api.Route, error)
-	DeleteRoute(ctx context.Context, vni uint32, prefix *netip.Prefix, ignoredErrors ...[]uint32) (*api.Route, error)
-
-	GetNat(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.Nat, error)
-	CreateNat(ctx context.Context, nat *api.Nat, ignoredErrors ...[]uint32) (*api.Nat, error)
-	DeleteNat(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.Nat, error)
-	ListLocalNats(ctx context.Context, natIP *netip.Addr, ignoredErrors ...[]uint32) (*api.NatList, error)
-
-	CreateNeighborNat(ctx context.Context, nat *api.NeighborNat, ignoredErrors ...[]uint32) (*api.NeighborNat, error)
-	ListNats(ctx context.Context, natIP *netip.Addr, natType string, ignoredErrors ...[]uint32) (*api.NatList, error)
-	DeleteNeighborNat(ctx context.Context, neigbhorNat *api.NeighborNat, ignoredErrors ...[]uint32) (*api.NeighborNat, error)
-	ListNeighborNats(ctx context.Context, natIP *netip.Addr, ignoredErrors ...[]uint32) (*api.NatList, error)
-
-	ListFirewallRules(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.FirewallRuleList, error)
-	CreateFirewallRule(ctx context.Context, fwRule *api.FirewallRule, ignoredErrors ...[]uint32) (*api.FirewallRule, error)
-	GetFirewallRule(ctx context.Context, interfaceID string, ruleID string, ignoredErrors ...[]uint32) (*api.FirewallRule, error)
-	DeleteFirewallRule(ctx context.Context, interfaceID string, ruleID string, ignoredErrors ...[]uint32) (*api.FirewallRule, error)
-
-	CheckInitialized(ctx context.Context, ignoredErrors ...[]uint32) (*api.Initialized, error)
-	Initialize(ctx context.Context, ignoredErrors ...[]uint32) (*api.Initialized, error)
-	GetVni(ctx context.Context, vni uint32, vniType uint8, ignoredErrors ...[]uint32) (*api.Vni, error)
-	ResetVni(ctx context.Context, vni uint32, vniType uint8, ignoredErrors ...[]uint32) (*api.Vni, error)
-	GetVersion(ctx context.Context, version *api.Version, ignoredErrors ...[]uint32) (*api.Version, error)
-
-	CaptureStart(ctx context.Context, capture *api.CaptureStart, ignoredErrors ...[]uint32) (*api.CaptureStart, error)
-	CaptureStop(ctx context.Context, ignoredErrors ...[]uint32) (*api.CaptureStop, error)
-	CaptureStatus(ctx context.Context, ignoredErrors ...[]uint32) (*api.CaptureStatus, error)
+	GetLoadBalancer(ctx context.Context, id string, opts ...CallOption) (*api.LoadBalancer, error)
+	CreateLoadBalancer(ctx context.Context, lb *api.LoadBalancer, opts ...CallOption) (*api.LoadBalancer, error)
+	DeleteLoadBalancer(ctx context.Context, id string, opts ...CallOption) (*api.LoadBalancer, error)
+
+	ListLoadBalancerPrefixes(ctx context.Context, interfaceID string, opts ...CallOption) (*api.PrefixList, error)
+	CreateLoadBalancerPrefix(ctx context.Context, prefix *api.LoadBalancerPrefix, opts ...CallOption) (*api.LoadBalancerPrefix, error)
+	DeleteLoadBalancerPrefix(ctx context.Context, interfaceID string, prefix *netip.Prefix, opts ...CallOption) (*api.LoadBalancerPrefix, error)
+
+	ListLoadBalancerTargets(ctx context.Context, interfaceID string, opts ...CallOption) (*api.LoadBalancerTargetList, error)
+	CreateLoadBalancerTarget(ctx context.Context, lbtarget *api.LoadBalancerTarget, opts ...CallOption) (*api.LoadBalancerTarget, error)
+	DeleteLoadBalancerTarget(ctx context.Context, id string, targetIP *netip.Addr, opts ...CallOption) (*api.LoadBalancerTarget, error)
+
+	GetInterface(ctx context.Context, id string, opts ...CallOption) (*api.Interface, error)
+	ListInterfaces(ctx context.Context, opts ...CallOption) (*api.InterfaceList, error)
+	CreateInterface(ctx context.Context, iface *api.Interface, opts ...CallOption) (*api.Interface, error)
+	DeleteInterface(ctx context.Context, id string, opts ...CallOption) (*api.Interface, error)
+
+	GetVirtualIP(ctx context.Context, interfaceID string, opts ...CallOption) (*api.VirtualIP, error)
+	CreateVirtualIP(ctx context.Context, virtualIP *api.VirtualIP, opts ...CallOption) (*api.VirtualIP, error)
+	DeleteVirtualIP(ctx context.Context, interfaceID string, opts ...CallOption) (*api.VirtualIP, error)
+
+	ListPrefixes(ctx context.Context, interfaceID string, opts ...CallOption) (*api.PrefixList, error)
+	CreatePrefix(ctx context.Context, prefix *api.Prefix, opts ...CallOption) (*api.Prefix, error)
+	DeletePrefix(ctx context.Context, interfaceID string, prefix *netip.Prefix, opts ...CallOption) (*api.Prefix, error)
+
+	ListRoutes(ctx context.Context, vni uint32, opts ...CallOption) (*api.RouteList, error)
+	CreateRoute(ctx context.Context, route *api.Route, opts ...CallOption) (*api.Route, error)
+	DeleteRoute(ctx context.Context, vni uint32, prefix *netip.Prefix, opts ...CallOption) (*api.Route, error)
+
+	GetNat(ctx context.Context, interfaceID string, opts ...CallOption) (*api.Nat, error)
+	CreateNat(ctx context.Context, nat *api.Nat, opts ...CallOption) (*api.Nat, error)
+	DeleteNat(ctx context.Context, interfaceID string, opts ...CallOption) (*api.Nat, error)
+	ListLocalNats(ctx context.Context, natIP *netip.Addr, opts ...CallOption) (*api.NatList, error)
+
+	CreateNeighborNat(ctx context.Context, nat *api.NeighborNat, opts ...CallOption) (*api.NeighborNat, error)
+	ListNats(ctx context.Context, natIP *netip.Addr, natType string, opts ...CallOption) (*api.NatList, error)
+	DeleteNeighborNat(ctx context.Context, neigbhorNat *api.NeighborNat, opts ...CallOption) (*api.NeighborNat, error)
+	ListNeighborNats(ctx context.Context, natIP *netip.Addr, opts ...CallOption) (*api.NatList, error)
+
+	ListFirewallRules(ctx context.Context, interfaceID string, opts ...CallOption) (*api.FirewallRuleList, error)
+	CreateFirewallRule(ctx context.Context, fwRule *api.FirewallRule, opts ...CallOption) (*api.FirewallRule, error)
+	GetFirewallRule(ctx context.Context, interfaceID string, ruleID string, opts ...CallOption) (*api.FirewallRule, error)
+	DeleteFirewallRule(ctx context.Context, interfaceID string, ruleID string, opts ...CallOption) (*api.FirewallRule, error)
+
+	CheckInitialized(ctx context.Context, opts ...CallOption) (*api.Initialized, error)
+	Initialize(ctx context.Context, opts ...CallOption) (*api.Initialized, error)
+	GetVni(ctx context.Context, vni uint32, vniType uint8, opts ...CallOption) (*api.Vni, error)
+	ResetVni(ctx context.Context, vni uint32, vniType uint8, opts ...CallOption) (*api.Vni, error)
+	GetVersion(ctx context.Context, version *api.Version, opts ...CallOption) (*api.Version, error)
+
+	CaptureStart(ctx context.Context, capture *api.CaptureStart, opts ...CallOption) (*api.CaptureStart, error)
+	CaptureStop(ctx context.Context, opts ...CallOption) (*api.CaptureStop, error)
+	CaptureStatus(ctx context.Context, opts ...CallOption) (*api.CaptureStatus, error)
+
+	// Close releases the connection owned by the client.
+	// Clients created with NewClient do not own a connection, closing them is a no-op.
+	Close() error
 }
 
 type client struct {
 	dpdkproto.DPDKironcoreClient
+	conn io.Closer
 }
 
-func NewClient(protoClient dpdkproto.DPDKironcoreClient) Client {
-	return &client{protoClient}
+// NewClient wraps an existing dpdkproto client. Prefer Dial, which also manages the connection.
+func NewClient(protoClient dpdkproto.DPDKironcoreClient, opts ...Option) Client {
+	return newClient(protoClient, nil, newOptions(opts))
 }
 
-func (c *client) GetLoadBalancer(ctx context.Context, id string, ignoredErrors ...[]uint32) (*api.LoadBalancer, error) {
+func newClient(protoClient dpdkproto.DPDKironcoreClient, conn io.Closer, o *options) Client {
+	c := &client{DPDKironcoreClient: protoClient, conn: conn}
+	if o.log.GetSink() == nil {
+		return c
+	}
+	return &loggingClient{Client: c, log: o.log, callLevel: o.callLogLevel, ignoredLevel: o.ignoredLogLevel}
+}
+
+func (c *client) Close() error {
+	if c.conn == nil {
+		return nil
+	}
+	return c.conn.Close()
+}
+
+func (c *client) GetLoadBalancer(ctx context.Context, id string, opts ...CallOption) (*api.LoadBalancer, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
 	res, err := c.DPDKironcoreClient.GetLoadBalancer(ctx, &dpdkproto.GetLoadBalancerRequest{
 		LoadbalancerId: []byte(id),
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.LoadBalancer{}, err
 	}
@@ -91,12 +116,19 @@ func (c *client) GetLoadBalancer(ctx context.Context, id string, ignoredErrors .
 		Status:           api.ProtoStatusToStatus(res.Status),
 	}
 	if res.GetStatus().GetCode() != 0 {
-		return retLoadBalancer, errors.GetError(res.Status, ignoredErrors)
+		return retLoadBalancer, o.getError(res.Status)
 	}
 	return api.ProtoLoadBalancerToLoadBalancer(res, id)
 }
 
-func (c *client) CreateLoadBalancer(ctx context.Context, lb *api.LoadBalancer, ignoredErrors ...[]uint32) (*api.LoadBalancer, error) {
+func (c *client) CreateLoadBalancer(ctx context.Context, lb *api.LoadBalancer, opts ...CallOption) (*api.LoadBalancer, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
+	if err := lb.Validate(); err != nil {
+		return &api.LoadBalancer{}, err
+	}
 	var lbPorts = make([]*dpdkproto.LbPort, 0, len(lb.Spec.Lbports))
 	for _, p := range lb.Spec.Lbports {
 		lbPort := &dpdkproto.LbPort{Port: p.Port, Protocol: dpdkproto.Protocol(p.Protocol)}
@@ -107,7 +139,7 @@ func (c *client) CreateLoadBalancer(ctx context.Context, lb *api.LoadBalancer, i
 		Vni:               lb.Spec.VNI,
 		LoadbalancedIp:    api.NetIPAddrToProtoIpAddress(lb.Spec.LbVipIP),
 		LoadbalancedPorts: lbPorts,
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.LoadBalancer{}, err
 	}
@@ -117,7 +149,7 @@ func (c *client) CreateLoadBalancer(ctx context.Context, lb *api.LoadBalancer, i
 		Status:           api.ProtoStatusToStatus(res.Status),
 	}
 	if res.GetStatus().GetCode() != 0 {
-		return retLoadBalancer, errors.GetError(res.Status, ignoredErrors)
+		return retLoadBalancer, o.getError(res.Status)
 	}
 
 	underlayRoute, err := netip.ParseAddr(string(res.GetUnderlayRoute()))
@@ -130,10 +162,14 @@ func (c *client) CreateLoadBalancer(ctx context.Context, lb *api.LoadBalancer, i
 	return retLoadBalancer, nil
 }
 
-func (c *client) DeleteLoadBalancer(ctx context.Context, id string, ignoredErrors ...[]uint32) (*api.LoadBalancer, error) {
+func (c *client) DeleteLoadBalancer(ctx context.Context, id string, opts ...CallOption) (*api.LoadBalancer, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
 	res, err := c.DPDKironcoreClient.DeleteLoadBalancer(ctx, &dpdkproto.DeleteLoadBalancerRequest{
 		LoadbalancerId: []byte(id),
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.LoadBalancer{}, err
 	}
@@ -143,15 +179,19 @@ func (c *client) DeleteLoadBalancer(ctx context.Context, id string, ignoredError
 		Status:           api.ProtoStatusToStatus(res.Status),
 	}
 	if res.GetStatus().GetCode() != 0 {
-		return retLoadBalancer, errors.GetError(res.Status, ignoredErrors)
+		return retLoadBalancer, o.getError(res.Status)
 	}
 	return retLoadBalancer, nil
 }
 
-func (c *client) ListLoadBalancerPrefixes(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.PrefixList, error) {
+func (c *client) ListLoadBalancerPrefixes(ctx context.Context, interfaceID string, opts ...CallOption) (*api.PrefixList, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
 	res, err := c.DPDKironcoreClient.ListLoadBalancerPrefixes(ctx, &dpdkproto.ListLoadBalancerPrefixesRequest{
 		InterfaceId: []byte(interfaceID),
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return nil, err
 	}
@@ -175,7 +215,14 @@ func (c *client) ListLoadBalancerPrefixes(ctx context.Context, interfaceID strin
 	}, nil
 }
 
-func (c *client) CreateLoadBalancerPrefix(ctx context.Context, lbprefix *api.LoadBalancerPrefix, ignoredErrors ...[]uint32) (*api.LoadBalancerPrefix, error) {
+func (c *client) CreateLoadBalancerPrefix(ctx context.Context, lbprefix *api.LoadBalancerPrefix, opts ...CallOption) (*api.LoadBalancerPrefix, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
+	if err := lbprefix.Validate(); err != nil {
+		return &api.LoadBalancerPrefix{}, err
+	}
 	lbPrefixAddr := lbprefix.Spec.Prefix.Addr()
 	res, err := c.DPDKironcoreClient.CreateLoadBalancerPrefix(ctx, &dpdkproto.CreateLoadBalancerPrefixRequest{
 		InterfaceId: []byte(lbprefix.InterfaceID),
@@ -183,7 +230,7 @@ func (c *client) CreateLoadBalancerPrefix(ctx context.Context, lbprefix *api.Loa
 			Ip:     api.NetIPAddrToProtoIpAddress(&lbPrefixAddr),
 			Length: uint32(lbprefix.Spec.Prefix.Bits()),
 		},
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.LoadBalancerPrefix{}, err
 	}
@@ -196,7 +243,7 @@ func (c *client) CreateLoadBalancerPrefix(ctx context.Context, lbprefix *api.Loa
 		Status: api.ProtoStatusToStatus(res.Status),
 	}
 	if res.GetStatus().GetCode() != 0 {
-		return retLBPrefix, errors.GetError(res.Status, ignoredErrors)
+		return retLBPrefix, o.getError(res.Status)
 	}
 	underlayRoute, err := netip.ParseAddr(string(res.GetUnderlayRoute()))
 	if err != nil {
@@ -206,7 +253,14 @@ func (c *client) CreateLoadBalancerPrefix(ctx context.Context, lbprefix *api.Loa
 	return retLBPrefix, nil
 }
 
-func (c *client) DeleteLoadBalancerPrefix(ctx context.Context, interfaceID string, prefix *netip.Prefix, ignoredErrors ...[]uint32) (*api.LoadBalancerPrefix, error) {
+func (c *client) DeleteLoadBalancerPrefix(ctx context.Context, interfaceID string, prefix *netip.Prefix, opts ...CallOption) (*api.LoadBalancerPrefix, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
+	if prefix == nil {
+		return &api.LoadBalancerPrefix{}, fmt.Errorf("prefix needs to be specified")
+	}
 	lbPrefixAddr := prefix.Addr()
 	res, err := c.DPDKironcoreClient.DeleteLoadBalancerPrefix(ctx, &dpdkproto.DeleteLoadBalancerPrefixRequest{
 		InterfaceId: []byte(interfaceID),
@@ -214,7 +268,7 @@ func (c *client) DeleteLoadBalancerPrefix(ctx context.Context, interfaceID strin
 			Ip:     api.NetIPAddrToProtoIpAddress(&lbPrefixAddr),
 			Length: uint32(prefix.Bits()),
 		},
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.LoadBalancerPrefix{}, err
 	}
@@ -225,22 +279,26 @@ func (c *client) DeleteLoadBalancerPrefix(ctx context.Context, interfaceID strin
 		Status:                 api.ProtoStatusToStatus(res.Status),
 	}
 	if res.GetStatus().GetCode() != 0 {
-		return retLBPrefix, errors.GetError(res.Status, ignoredErrors)
+		return retLBPrefix, o.getError(res.Status)
 	}
 	return retLBPrefix, nil
 }
 
-func (c *client) ListLoadBalancerTargets(ctx context.Context, loadBalancerID string, ignoredErrors ...[]uint32) (*api.LoadBalancerTargetList, error) {
+func (c *client) ListLoadBalancerTargets(ctx context.Context, loadBalancerID string, opts ...CallOption) (*api.LoadBalancerTargetList, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
 	res, err := c.DPDKironcoreClient.ListLoadBalancerTargets(ctx, &dpdkproto.ListLoadBalancerTargetsRequest{
 		LoadbalancerId: []byte(loadBalancerID),
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.LoadBalancerTargetList{}, err
 	}
 	if res.GetStatus().GetCode() != 0 {
 		return &api.LoadBalancerTargetList{
 			TypeMeta: api.TypeMeta{Kind: api.LoadBalancerTargetListKind},
-			Status:   api.ProtoStatusToStatus(res.Status)}, errors.GetError(res.Status, ignoredErrors)
+			Status:   api.ProtoStatusToStatus(res.Status)}, o.getError(res.Status)
 	}
 
 	lbtargets := make([]api.LoadBalancerTarget, len(res.GetTargetIps()))
@@ -264,11 +322,18 @@ func (c *client) ListLoadBalancerTargets(ctx context.Context, loadBalancerID str
 	}, nil
 }
 
-func (c *client) CreateLoadBalancerTarget(ctx context.Context, lbtarget *api.LoadBalancerTarget, ignoredErrors ...[]uint32) (*api.LoadBalancerTarget, error) {
+func (c *client) CreateLoadBalancerTarget(ctx context.Context, lbtarget *api.LoadBalancerTarget, opts ...CallOption) (*api.LoadBalancerTarget, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
+	if err := lbtarget.Validate(); err != nil {
+		return &api.LoadBalancerTarget{}, err
+	}
 	res, err := c.DPDKironcoreClient.CreateLoadBalancerTarget(ctx, &dpdkproto.CreateLoadBalancerTargetRequest{
 		LoadbalancerId: []byte(lbtarget.LoadBalancerTargetMeta.LoadbalancerID),
 		TargetIp:       api.NetIPAddrToProtoIpAddress(lbtarget.Spec.TargetIP),
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.LoadBalancerTarget{}, err
 	}
@@ -278,17 +343,21 @@ func (c *client) CreateLoadBalancerTarget(ctx context.Context, lbtarget *api.Loa
 		Status:                 api.ProtoStatusToStatus(res.Status),
 	}
 	if res.GetStatus().GetCode() != 0 {
-		return retLBTarget, errors.GetError(res.Status, ignoredErrors)
+		return retLBTarget, o.getError(res.Status)
 	}
 	retLBTarget.Spec = lbtarget.Spec
 	return retLBTarget, nil
 }
 
-func (c *client) DeleteLoadBalancerTarget(ctx context.Context, lbid string, targetIP *netip.Addr, ignoredErrors ...[]uint32) (*api.LoadBalancerTarget, error) {
+func (c *client) DeleteLoadBalancerTarget(ctx context.Context, lbid string, targetIP *netip.Addr, opts ...CallOption) (*api.LoadBalancerTarget, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
 	res, err := c.DPDKironcoreClient.DeleteLoadBalancerTarget(ctx, &dpdkproto.DeleteLoadBalancerTargetRequest{
 		LoadbalancerId: []byte(lbid),
 		TargetIp:       api.NetIPAddrToProtoIpAddress(targetIP),
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.LoadBalancerTarget{}, err
 	}
@@ -298,15 +367,19 @@ func (c *client) DeleteLoadBalancerTarget(ctx context.Context, lbid string, targ
 		Status:                 api.ProtoStatusToStatus(res.Status),
 	}
 	if res.Status.GetCode() != 0 {
-		return retLBTarget, errors.GetError(res.Status, ignoredErrors)
+		return retLBTarget, o.getError(res.Status)
 	}
 	return retLBTarget, nil
 }
 
-func (c *client) GetInterface(ctx context.Context, id string, ignoredErrors ...[]uint32) (*api.Interface, error) {
+func (c *client) GetInterface(ctx context.Context, id string, opts ...CallOption) (*api.Interface, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
 	res, err := c.DPDKironcoreClient.GetInterface(ctx, &dpdkproto.GetInterfaceRequest{
 		InterfaceId: []byte(id),
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.Interface{}, err
 	}
@@ -314,13 +387,21 @@ func (c *client) GetInterface(ctx context.Context, id string, ignoredErrors ...[
 		return &api.Interface{
 			TypeMeta:      api.TypeMeta{Kind: api.InterfaceKind},
 			InterfaceMeta: api.InterfaceMeta{ID: id},
-			Status:        api.ProtoStatusToStatus(res.Status)}, errors.GetError(res.Status, ignoredErrors)
+			Status:        api.ProtoStatusToStatus(res.Status)}, o.getError(res.Status)
+	}
+	iface, err := api.ProtoInterfaceToInterface(res.GetInterface())
+	if err != nil || !o.details {
+		return iface, err
 	}
-	return api.ProtoInterfaceToInterface(res.GetInterface())
+	return iface, c.addInterfaceDetails(ctx, iface, o)
 }
 
-func (c *client) ListInterfaces(ctx context.Context, ignoredErrors ...[]uint32) (*api.InterfaceList, error) {
-	res, err := c.DPDKironcoreClient.ListInterfaces(ctx, &dpdkproto.ListInterfacesRequest{})
+func (c *client) ListInterfaces(ctx context.Context, opts ...CallOption) (*api.InterfaceList, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
+	res, err := c.DPDKironcoreClient.ListInterfaces(ctx, &dpdkproto.ListInterfacesRequest{}, o.grpcOptions...)
 	if err != nil {
 		return nil, err
 	}
@@ -334,6 +415,11 @@ func (c *client) ListInterfaces(ctx context.Context, ignoredErrors ...[]uint32)
 
 		ifaces[i] = *iface
 	}
+	if o.details {
+		if err := c.addInterfacesDetails(ctx, ifaces, o); err != nil {
+			return nil, err
+		}
+	}
 
 	return &api.InterfaceList{
 		TypeMeta: api.TypeMeta{Kind: api.InterfaceListKind},
@@ -342,9 +428,20 @@ func (c *client) ListInterfaces(ctx context.Context, ignoredErrors ...[]uint32)
 	}, nil
 }
 
-func (c *client) CreateInterface(ctx context.Context, iface *api.Interface, ignoredErrors ...[]uint32) (*api.Interface, error) {
+func (c *client) CreateInterface(ctx context.Context, iface *api.Interface, opts ...CallOption) (*api.Interface, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
+	if err := iface.Validate(); err != nil {
+		return &api.Interface{}, err
+	}
+	interfaceType, err := api.InterfaceTypeToProtoInterfaceType(iface.Spec.Type)
+	if err != nil {
+		return &api.Interface{}, err
+	}
 	req := dpdkproto.CreateInterfaceRequest{
-		InterfaceType:      dpdkproto.InterfaceType_VIRTUAL,
+		InterfaceType:      interfaceType,
 		InterfaceId:        []byte(iface.ID),
 		Vni:                iface.Spec.VNI,
 		Ipv4Config:         api.NetIPAddrToProtoIPConfig(iface.Spec.IPv4),
@@ -358,7 +455,7 @@ func (c *client) CreateInterface(ctx context.Context, iface *api.Interface, igno
 		}
 	}
 
-	res, err := c.DPDKironcoreClient.CreateInterface(ctx, &req)
+	res, err := c.DPDKironcoreClient.CreateInterface(ctx, &req, o.grpcOptions...)
 	if err != nil {
 		return &api.Interface{}, err
 	}
@@ -368,7 +465,7 @@ func (c *client) CreateInterface(ctx context.Context, iface *api.Interface, igno
 		Status:        api.ProtoStatusToStatus(res.Status),
 	}
 	if res.GetStatus().GetCode() != 0 {
-		return retInterface, errors.GetError(res.Status, ignoredErrors)
+		return retInterface, o.getError(res.Status)
 	}
 
 	underlayRoute, err := netip.ParseAddr(string(res.GetUnderlayRoute()))
@@ -376,18 +473,25 @@ func (c *client) CreateInterface(ctx context.Context, iface *api.Interface, igno
 		return retInterface, fmt.Errorf("error parsing underlay route: %w", err)
 	}
 	retInterface.Spec = iface.Spec
+	retInterface.Spec.Type, _ = api.ProtoInterfaceTypeToInterfaceType(interfaceType)
 	retInterface.Spec.UnderlayRoute = &underlayRoute
-	retInterface.Spec.VirtualFunction = &api.VirtualFunction{
-		Name: res.Vf.Name,
+	if interfaceType == dpdkproto.InterfaceType_VIRTUAL && res.GetVf() != nil {
+		retInterface.Spec.VirtualFunction = &api.VirtualFunction{
+			Name: res.GetVf().GetName(),
+		}
 	}
 
 	return retInterface, nil
 }
 
-func (c *client) DeleteInterface(ctx context.Context, id string, ignoredErrors ...[]uint32) (*api.Interface, error) {
+func (c *client) DeleteInterface(ctx context.Context, id string, opts ...CallOption) (*api.Interface, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
 	res, err := c.DPDKironcoreClient.DeleteInterface(ctx, &dpdkproto.DeleteInterfaceRequest{
 		InterfaceId: []byte(id),
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.Interface{}, err
 	}
@@ -397,15 +501,19 @@ func (c *client) DeleteInterface(ctx context.Context, id string, ignoredErrors .
 		Status:        api.ProtoStatusToStatus(res.Status),
 	}
 	if res.GetStatus().GetCode() != 0 {
-		return retInterface, errors.GetError(res.Status, ignoredErrors)
+		return retInterface, o.getError(res.Status)
 	}
 	return retInterface, nil
 }
 
-func (c *client) GetVirtualIP(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.VirtualIP, error) {
+func (c *client) GetVirtualIP(ctx context.Context, interfaceID string, opts ...CallOption) (*api.VirtualIP, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
 	res, err := c.DPDKironcoreClient.GetVip(ctx, &dpdkproto.GetVipRequest{
 		InterfaceId: []byte(interfaceID),
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.VirtualIP{}, err
 	}
@@ -413,16 +521,23 @@ func (c *client) GetVirtualIP(ctx context.Context, interfaceID string, ignoredEr
 		return &api.VirtualIP{
 			TypeMeta:      api.TypeMeta{Kind: api.VirtualIPKind},
 			VirtualIPMeta: api.VirtualIPMeta{InterfaceID: interfaceID},
-			Status:        api.ProtoStatusToStatus(res.Status)}, errors.GetError(res.Status, ignoredErrors)
+			Status:        api.ProtoStatusToStatus(res.Status)}, o.getError(res.Status)
 	}
 	return api.ProtoVirtualIPToVirtualIP(interfaceID, res)
 }
 
-func (c *client) CreateVirtualIP(ctx context.Context, virtualIP *api.VirtualIP, ignoredErrors ...[]uint32) (*api.VirtualIP, error) {
+func (c *client) CreateVirtualIP(ctx context.Context, virtualIP *api.VirtualIP, opts ...CallOption) (*api.VirtualIP, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
+	if err := virtualIP.Validate(); err != nil {
+		return &api.VirtualIP{}, err
+	}
 	res, err := c.DPDKironcoreClient.CreateVip(ctx, &dpdkproto.CreateVipRequest{
 		InterfaceId: []byte(virtualIP.InterfaceID),
 		VipIp:       api.NetIPAddrToProtoIpAddress(virtualIP.Spec.IP),
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.VirtualIP{}, err
 	}
@@ -435,7 +550,7 @@ func (c *client) CreateVirtualIP(ctx context.Context, virtualIP *api.VirtualIP,
 		Status: api.ProtoStatusToStatus(res.Status),
 	}
 	if res.GetStatus().GetCode() != 0 {
-		return retVirtualIP, errors.GetError(res.Status, ignoredErrors)
+		return retVirtualIP, o.getError(res.Status)
 	}
 	underlayRoute, err := netip.ParseAddr(string(res.GetUnderlayRoute()))
 	if err != nil {
@@ -445,10 +560,14 @@ func (c *client) CreateVirtualIP(ctx context.Context, virtualIP *api.VirtualIP,
 	return retVirtualIP, nil
 }
 
-func (c *client) DeleteVirtualIP(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.VirtualIP, error) {
+func (c *client) DeleteVirtualIP(ctx context.Context, interfaceID string, opts ...CallOption) (*api.VirtualIP, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
 	res, err := c.DPDKironcoreClient.DeleteVip(ctx, &dpdkproto.DeleteVipRequest{
 		InterfaceId: []byte(interfaceID),
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.VirtualIP{}, err
 	}
@@ -458,15 +577,19 @@ func (c *client) DeleteVirtualIP(ctx context.Context, interfaceID string, ignore
 		Status:        api.ProtoStatusToStatus(res.Status),
 	}
 	if res.GetStatus().GetCode() != 0 {
-		return retVirtualIP, errors.GetError(res.Status, ignoredErrors)
+		return retVirtualIP, o.getError(res.Status)
 	}
 	return retVirtualIP, nil
 }
 
-func (c *client) ListPrefixes(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.PrefixList, error) {
+func (c *client) ListPrefixes(ctx context.Context, interfaceID string, opts ...CallOption) (*api.PrefixList, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
 	res, err := c.DPDKironcoreClient.ListPrefixes(ctx, &dpdkproto.ListPrefixesRequest{
 		InterfaceId: []byte(interfaceID),
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return nil, err
 	}
@@ -489,7 +612,14 @@ func (c *client) ListPrefixes(ctx context.Context, interfaceID string, ignoredEr
 	}, nil
 }
 
-func (c *client) CreatePrefix(ctx context.Context, prefix *api.Prefix, ignoredErrors ...[]uint32) (*api.Prefix, error) {
+func (c *client) CreatePrefix(ctx context.Context, prefix *api.Prefix, opts ...CallOption) (*api.Prefix, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
+	if err := prefix.Validate(); err != nil {
+		return &api.Prefix{}, err
+	}
 	prefixAddr := prefix.Spec.Prefix.Addr()
 	res, err := c.DPDKironcoreClient.CreatePrefix(ctx, &dpdkproto.CreatePrefixRequest{
 		InterfaceId: []byte(prefix.InterfaceID),
@@ -497,7 +627,7 @@ func (c *client) CreatePrefix(ctx context.Context, prefix *api.Prefix, ignoredEr
 			Ip:     api.NetIPAddrToProtoIpAddress(&prefixAddr),
 			Length: uint32(prefix.Spec.Prefix.Bits()),
 		},
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.Prefix{}, err
 	}
@@ -509,7 +639,7 @@ func (c *client) CreatePrefix(ctx context.Context, prefix *api.Prefix, ignoredEr
 	}
 
 	if res.GetStatus().GetCode() != 0 {
-		return retPrefix, errors.GetError(res.Status, ignoredErrors)
+		return retPrefix, o.getError(res.Status)
 	}
 	underlayRoute, err := netip.ParseAddr(string(res.GetUnderlayRoute()))
 	if err != nil {
@@ -519,7 +649,14 @@ func (c *client) CreatePrefix(ctx context.Context, prefix *api.Prefix, ignoredEr
 	return retPrefix, nil
 }
 
-func (c *client) DeletePrefix(ctx context.Context, interfaceID string, prefix *netip.Prefix, ignoredErrors ...[]uint32) (*api.Prefix, error) {
+func (c *client) DeletePrefix(ctx context.Context, interfaceID string, prefix *netip.Prefix, opts ...CallOption) (*api.Prefix, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
+	if prefix == nil {
+		return &api.Prefix{}, fmt.Errorf("prefix needs to be specified")
+	}
 	prefixAddr := prefix.Addr()
 	res, err := c.DPDKironcoreClient.DeletePrefix(ctx, &dpdkproto.DeletePrefixRequest{
 		InterfaceId: []byte(interfaceID),
@@ -527,7 +664,7 @@ func (c *client) DeletePrefix(ctx context.Context, interfaceID string, prefix *n
 			Ip:     api.NetIPAddrToProtoIpAddress(&prefixAddr),
 			Length: uint32(prefix.Bits()),
 		},
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.Prefix{}, err
 	}
@@ -538,20 +675,20 @@ func (c *client) DeletePrefix(ctx context.Context, interfaceID string, prefix *n
 		Status:     api.ProtoStatusToStatus(res.Status),
 	}
 	if res.GetStatus().GetCode() != 0 {
-		return retPrefix, errors.GetError(res.Status, ignoredErrors)
+		return retPrefix, o.getError(res.Status)
 	}
 	return retPrefix, nil
 }
 
-func (c *client) CreateRoute(ctx context.Context, route *api.Route, ignoredErrors ...[]uint32) (*api.Route, error) {
-	if route.Spec.Prefix == nil {
-		return nil, fmt.Errorf("prefix needs to be specified")
-	}
-	routePrefixAddr := route.Spec.Prefix.Addr()
+func (c *client) CreateRoute(ctx context.Context, route *api.Route, opts ...CallOption) (*api.Route, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
 
-	if route.Spec.NextHop == nil {
-		return nil, fmt.Errorf("nextHop needs to be specified")
+	if err := route.Validate(); err != nil {
+		return &api.Route{}, err
 	}
+	routePrefixAddr := route.Spec.Prefix.Addr()
 	res, err := c.DPDKironcoreClient.CreateRoute(ctx, &dpdkproto.CreateRouteRequest{
 		Vni: route.VNI,
 		Route: &dpdkproto.Route{
@@ -563,7 +700,7 @@ func (c *client) CreateRoute(ctx context.Context, route *api.Route, ignoredError
 			NexthopVni:     route.Spec.NextHop.VNI,
 			NexthopAddress: api.NetIPAddrToProtoIpAddress(route.Spec.NextHop.IP),
 		},
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.Route{}, err
 	}
@@ -576,13 +713,20 @@ func (c *client) CreateRoute(ctx context.Context, route *api.Route, ignoredError
 		Status: api.ProtoStatusToStatus(res.Status),
 	}
 	if res.GetStatus().GetCode() != 0 {
-		return retRoute, errors.GetError(res.Status, ignoredErrors)
+		return retRoute, o.getError(res.Status)
 	}
 	retRoute.Spec = route.Spec
 	return retRoute, nil
 }
 
-func (c *client) DeleteRoute(ctx context.Context, vni uint32, prefix *netip.Prefix, ignoredErrors ...[]uint32) (*api.Route, error) {
+func (c *client) DeleteRoute(ctx context.Context, vni uint32, prefix *netip.Prefix, opts ...CallOption) (*api.Route, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
+	if prefix == nil {
+		return &api.Route{}, fmt.Errorf("prefix needs to be specified")
+	}
 	routePrefixAddr := prefix.Addr()
 	res, err := c.DPDKironcoreClient.DeleteRoute(ctx, &dpdkproto.DeleteRouteRequest{
 		Vni: vni,
@@ -593,7 +737,7 @@ func (c *client) DeleteRoute(ctx context.Context, vni uint32, prefix *netip.Pref
 				Length: uint32(prefix.Bits()),
 			},
 		},
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.Route{}, err
 	}
@@ -607,15 +751,19 @@ func (c *client) DeleteRoute(ctx context.Context, vni uint32, prefix *netip.Pref
 		Status: api.ProtoStatusToStatus(res.Status),
 	}
 	if res.GetStatus().GetCode() != 0 {
-		return retRoute, errors.GetError(res.Status, ignoredErrors)
+		return retRoute, o.getError(res.Status)
 	}
 	return retRoute, nil
 }
 
-func (c *client) ListRoutes(ctx context.Context, vni uint32, ignoredErrors ...[]uint32) (*api.RouteList, error) {
+func (c *client) ListRoutes(ctx context.Context, vni uint32, opts ...CallOption) (*api.RouteList, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
 	res, err := c.DPDKironcoreClient.ListRoutes(ctx, &dpdkproto.ListRoutesRequest{
 		Vni: vni,
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return nil, err
 	}
@@ -638,8 +786,12 @@ func (c *client) ListRoutes(ctx context.Context, vni uint32, ignoredErrors ...[]
 	}, nil
 }
 
-func (c *client) GetNat(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.Nat, error) {
-	res, err := c.DPDKironcoreClient.GetNat(ctx, &dpdkproto.GetNatRequest{InterfaceId: []byte(interfaceID)})
+func (c *client) GetNat(ctx context.Context, interfaceID string, opts ...CallOption) (*api.Nat, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
+	res, err := c.DPDKironcoreClient.GetNat(ctx, &dpdkproto.GetNatRequest{InterfaceId: []byte(interfaceID)}, o.grpcOptions...)
 	if err != nil {
 		return &api.Nat{}, err
 	}
@@ -647,18 +799,25 @@ func (c *client) GetNat(ctx context.Context, interfaceID string, ignoredErrors .
 		return &api.Nat{
 			TypeMeta: api.TypeMeta{Kind: api.NatKind},
 			NatMeta:  api.NatMeta{InterfaceID: interfaceID},
-			Status:   api.ProtoStatusToStatus(res.Status)}, errors.GetError(res.Status, ignoredErrors)
+			Status:   api.ProtoStatusToStatus(res.Status)}, o.getError(res.Status)
 	}
 	return api.ProtoNatToNat(res, interfaceID)
 }
 
-func (c *client) CreateNat(ctx context.Context, nat *api.Nat, ignoredErrors ...[]uint32) (*api.Nat, error) {
+func (c *client) CreateNat(ctx context.Context, nat *api.Nat, opts ...CallOption) (*api.Nat, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
+	if err := nat.Validate(); err != nil {
+		return &api.Nat{}, err
+	}
 	res, err := c.DPDKironcoreClient.CreateNat(ctx, &dpdkproto.CreateNatRequest{
 		InterfaceId: []byte(nat.NatMeta.InterfaceID),
 		NatIp:       api.NetIPAddrToProtoIpAddress(nat.Spec.NatIP),
 		MinPort:     nat.Spec.MinPort,
 		MaxPort:     nat.Spec.MaxPort,
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.Nat{}, err
 	}
@@ -668,7 +827,7 @@ func (c *client) CreateNat(ctx context.Context, nat *api.Nat, ignoredErrors ...[
 		Status:   api.ProtoStatusToStatus(res.Status),
 	}
 	if res.GetStatus().GetCode() != 0 {
-		return retNat, errors.GetError(res.Status, ignoredErrors)
+		return retNat, o.getError(res.Status)
 	}
 
 	underlayRoute, err := netip.ParseAddr(string(res.GetUnderlayRoute()))
@@ -681,10 +840,14 @@ func (c *client) CreateNat(ctx context.Context, nat *api.Nat, ignoredErrors ...[
 	return retNat, nil
 }
 
-func (c *client) DeleteNat(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.Nat, error) {
+func (c *client) DeleteNat(ctx context.Context, interfaceID string, opts ...CallOption) (*api.Nat, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
 	res, err := c.DPDKironcoreClient.DeleteNat(ctx, &dpdkproto.DeleteNatRequest{
 		InterfaceId: []byte(interfaceID),
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.Nat{}, err
 	}
@@ -694,18 +857,22 @@ func (c *client) DeleteNat(ctx context.Context, interfaceID string, ignoredError
 		Status:   api.ProtoStatusToStatus(res.Status),
 	}
 	if res.Status.GetCode() != 0 {
-		return retNat, errors.GetError(res.Status, ignoredErrors)
+		return retNat, o.getError(res.Status)
 	}
 	return retNat, nil
 }
 
-func (c *client) ListLocalNats(ctx context.Context, natIP *netip.Addr, ignoredErrors ...[]uint32) (*api.NatList, error) {
-	return c.ListNats(ctx, natIP, "local", ignoredErrors...)
+func (c *client) ListLocalNats(ctx context.Context, natIP *netip.Addr, opts ...CallOption) (*api.NatList, error) {
+	return c.ListNats(ctx, natIP, "local", opts...)
 }
 
-func (c *client) CreateNeighborNat(ctx context.Context, nNat *api.NeighborNat, ignoredErrors ...[]uint32) (*api.NeighborNat, error) {
-	if nNat.Spec.UnderlayRoute == nil {
-		return nil, fmt.Errorf("underlayRoute needs to be specified")
+func (c *client) CreateNeighborNat(ctx context.Context, nNat *api.NeighborNat, opts ...CallOption) (*api.NeighborNat, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
+	if err := nNat.Validate(); err != nil {
+		return &api.NeighborNat{}, err
 	}
 	res, err := c.DPDKironcoreClient.CreateNeighborNat(ctx, &dpdkproto.CreateNeighborNatRequest{
 		NatIp:         api.NetIPAddrToProtoIpAddress(nNat.NatIP),
@@ -713,7 +880,7 @@ func (c *client) CreateNeighborNat(ctx context.Context, nNat *api.NeighborNat, i
 		MinPort:       nNat.Spec.MinPort,
 		MaxPort:       nNat.Spec.MaxPort,
 		UnderlayRoute: []byte(nNat.Spec.UnderlayRoute.String()),
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.NeighborNat{}, err
 	}
@@ -723,13 +890,17 @@ func (c *client) CreateNeighborNat(ctx context.Context, nNat *api.NeighborNat, i
 		Status:          api.ProtoStatusToStatus(res.Status),
 	}
 	if res.GetStatus().GetCode() != 0 {
-		return retnNat, errors.GetError(res.Status, ignoredErrors)
+		return retnNat, o.getError(res.Status)
 	}
 	retnNat.Spec = nNat.Spec
 	return retnNat, nil
 }
 
-func (c *client) ListNats(ctx context.Context, natIP *netip.Addr, natType string, ignoredErrors ...[]uint32) (*api.NatList, error) {
+func (c *client) ListNats(ctx context.Context, natIP *netip.Addr, natType string, opts ...CallOption) (*api.NatList, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
 	var nType int32
 	switch strings.ToLower(natType) {
 	case "local", "1":
@@ -749,25 +920,25 @@ func (c *client) ListNats(ctx context.Context, natIP *netip.Addr, natType string
 	var err error
 	switch nType {
 	case 0:
-		res1, err1 := c.DPDKironcoreClient.ListLocalNats(ctx, &dpdkproto.ListLocalNatsRequest{NatIp: req})
+		res1, err1 := c.DPDKironcoreClient.ListLocalNats(ctx, &dpdkproto.ListLocalNatsRequest{NatIp: req}, o.grpcOptions...)
 		if err1 != nil {
 			return nil, err1
 		}
-		res2, err2 := c.DPDKironcoreClient.ListNeighborNats(ctx, &dpdkproto.ListNeighborNatsRequest{NatIp: req})
+		res2, err2 := c.DPDKironcoreClient.ListNeighborNats(ctx, &dpdkproto.ListNeighborNatsRequest{NatIp: req}, o.grpcOptions...)
 		if err2 != nil {
 			return nil, err2
 		}
 		natEntries = append(natEntries, res1.NatEntries...)
 		natEntries = append(natEntries, res2.NatEntries...)
 	case 1:
-		res, err := c.DPDKironcoreClient.ListLocalNats(ctx, &dpdkproto.ListLocalNatsRequest{NatIp: req})
+		res, err := c.DPDKironcoreClient.ListLocalNats(ctx, &dpdkproto.ListLocalNatsRequest{NatIp: req}, o.grpcOptions...)
 		if err != nil {
 			return nil, err
 		}
 		natEntries = res.GetNatEntries()
 		status = res.Status
 	case 2:
-		res, err := c.DPDKironcoreClient.ListNeighborNats(ctx, &dpdkproto.ListNeighborNatsRequest{NatIp: req})
+		res, err := c.DPDKironcoreClient.ListNeighborNats(ctx, &dpdkproto.ListNeighborNatsRequest{NatIp: req}, o.grpcOptions...)
 		if err != nil {
 			return nil, err
 		}
@@ -809,13 +980,17 @@ func (c *client) ListNats(ctx context.Context, natIP *netip.Addr, natType string
 	}, nil
 }
 
-func (c *client) DeleteNeighborNat(ctx context.Context, neigbhorNat *api.NeighborNat, ignoredErrors ...[]uint32) (*api.NeighborNat, error) {
+func (c *client) DeleteNeighborNat(ctx context.Context, neigbhorNat *api.NeighborNat, opts ...CallOption) (*api.NeighborNat, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
 	res, err := c.DPDKironcoreClient.DeleteNeighborNat(ctx, &dpdkproto.DeleteNeighborNatRequest{
 		NatIp:   api.NetIPAddrToProtoIpAddress(neigbhorNat.NatIP),
 		Vni:     neigbhorNat.Spec.Vni,
 		MinPort: neigbhorNat.Spec.MinPort,
 		MaxPort: neigbhorNat.Spec.MaxPort,
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.NeighborNat{}, err
 	}
@@ -825,19 +1000,23 @@ func (c *client) DeleteNeighborNat(ctx context.Context, neigbhorNat *api.Neighbo
 		Status:          api.ProtoStatusToStatus(res.Status),
 	}
 	if res.GetStatus().GetCode() != 0 {
-		return nnat, errors.GetError(res.Status, ignoredErrors)
+		return nnat, o.getError(res.Status)
 	}
 	return nnat, nil
 }
 
-func (c *client) ListNeighborNats(ctx context.Context, natIP *netip.Addr, ignoredErrors ...[]uint32) (*api.NatList, error) {
-	return c.ListNats(ctx, natIP, "neigh", ignoredErrors...)
+func (c *client) ListNeighborNats(ctx context.Context, natIP *netip.Addr, opts ...CallOption) (*api.NatList, error) {
+	return c.ListNats(ctx, natIP, "neigh", opts...)
 }
 
-func (c *client) ListFirewallRules(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.FirewallRuleList, error) {
+func (c *client) ListFirewallRules(ctx context.Context, interfaceID string, opts ...CallOption) (*api.FirewallRuleList, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
 	res, err := c.DPDKironcoreClient.ListFirewallRules(ctx, &dpdkproto.ListFirewallRulesRequest{
 		InterfaceId: []byte(interfaceID),
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.FirewallRuleList{}, err
 	}
@@ -859,45 +1038,29 @@ func (c *client) ListFirewallRules(ctx context.Context, interfaceID string, igno
 	}, nil
 }
 
-func (c *client) CreateFirewallRule(ctx context.Context, fwRule *api.FirewallRule, ignoredErrors ...[]uint32) (*api.FirewallRule, error) {
-	var action, direction uint8
+func (c *client) CreateFirewallRule(ctx context.Context, fwRule *api.FirewallRule, opts ...CallOption) (*api.FirewallRule, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
 
-	switch strings.ToLower(fwRule.Spec.FirewallAction) {
-	case "accept", "allow", "1":
-		action = 1
-		fwRule.Spec.FirewallAction = "Accept"
-	case "drop", "deny", "0":
-		action = 0
-		fwRule.Spec.FirewallAction = "Drop"
-	default:
-		return &api.FirewallRule{}, fmt.Errorf("firewall action can be only: drop/deny/0|accept/allow/1")
+	if err := fwRule.Validate(); err != nil {
+		return &api.FirewallRule{}, err
 	}
 
-	switch strings.ToLower(fwRule.Spec.TrafficDirection) {
-	case "ingress", "0":
-		direction = 0
-		fwRule.Spec.TrafficDirection = "Ingress"
-	case "egress", "1":
-		direction = 1
-		fwRule.Spec.TrafficDirection = "Egress"
-	default:
-		return &api.FirewallRule{}, fmt.Errorf("traffic direction can be only: Ingress = 0/Egress = 1")
+	direction, action, err := api.NormalizeFirewallRule(fwRule.Spec.TrafficDirection, fwRule.Spec.FirewallAction)
+	if err != nil {
+		return &api.FirewallRule{}, err
 	}
+	fwRule.Spec.TrafficDirection, fwRule.Spec.FirewallAction = direction, action
 
-	if fwRule.Spec.SourcePrefix == nil {
-		return nil, fmt.Errorf("source prefix needs to be specified")
-	}
 	fwRuleSrcPrefixAddr := fwRule.Spec.SourcePrefix.Addr()
-	if fwRule.Spec.DestinationPrefix == nil {
-		return nil, fmt.Errorf("destination prefix needs to be specified")
-	}
 	fwRuleDstPrefixAddr := fwRule.Spec.DestinationPrefix.Addr()
 	req := dpdkproto.CreateFirewallRuleRequest{
 		InterfaceId: []byte(fwRule.FirewallRuleMeta.InterfaceID),
 		Rule: &dpdkproto.FirewallRule{
 			Id:        []byte(fwRule.Spec.RuleID),
-			Direction: dpdkproto.TrafficDirection(direction),
-			Action:    dpdkproto.FirewallAction(action),
+			Direction: dpdkproto.TrafficDirection(dpdkproto.TrafficDirection_value[strings.ToUpper(direction)]),
+			Action:    dpdkproto.FirewallAction(dpdkproto.FirewallAction_value[strings.ToUpper(action)]),
 			Priority:  fwRule.Spec.Priority,
 			SourcePrefix: &dpdkproto.Prefix{
 				Ip:     api.NetIPAddrToProtoIpAddress(&fwRuleSrcPrefixAddr),
@@ -911,7 +1074,7 @@ func (c *client) CreateFirewallRule(ctx context.Context, fwRule *api.FirewallRul
 		},
 	}
 
-	res, err := c.DPDKironcoreClient.CreateFirewallRule(ctx, &req)
+	res, err := c.DPDKironcoreClient.CreateFirewallRule(ctx, &req, o.grpcOptions...)
 	if err != nil {
 		return &api.FirewallRule{}, err
 	}
@@ -921,17 +1084,21 @@ func (c *client) CreateFirewallRule(ctx context.Context, fwRule *api.FirewallRul
 		Spec:             api.FirewallRuleSpec{RuleID: fwRule.Spec.RuleID},
 		Status:           api.ProtoStatusToStatus(res.Status)}
 	if res.GetStatus().GetCode() != 0 {
-		return retFwrule, errors.GetError(res.Status, ignoredErrors)
+		return retFwrule, o.getError(res.Status)
 	}
 	retFwrule.Spec = fwRule.Spec
 	return retFwrule, nil
 }
 
-func (c *client) GetFirewallRule(ctx context.Context, interfaceID string, ruleID string, ignoredErrors ...[]uint32) (*api.FirewallRule, error) {
+func (c *client) GetFirewallRule(ctx context.Context, interfaceID string, ruleID string, opts ...CallOption) (*api.FirewallRule, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
 	res, err := c.DPDKironcoreClient.GetFirewallRule(ctx, &dpdkproto.GetFirewallRuleRequest{
 		InterfaceId: []byte(interfaceID),
 		RuleId:      []byte(ruleID),
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.FirewallRule{}, err
 	}
@@ -941,17 +1108,21 @@ func (c *client) GetFirewallRule(ctx context.Context, interfaceID string, ruleID
 			FirewallRuleMeta: api.FirewallRuleMeta{InterfaceID: interfaceID},
 			Spec:             api.FirewallRuleSpec{RuleID: ruleID},
 			Status:           api.ProtoStatusToStatus(res.Status),
-		}, errors.GetError(res.Status, ignoredErrors)
+		}, o.getError(res.Status)
 	}
 
 	return api.ProtoFwRuleToFwRule(res.Rule, interfaceID)
 }
 
-func (c *client) DeleteFirewallRule(ctx context.Context, interfaceID string, ruleID string, ignoredErrors ...[]uint32) (*api.FirewallRule, error) {
+func (c *client) DeleteFirewallRule(ctx context.Context, interfaceID string, ruleID string, opts ...CallOption) (*api.FirewallRule, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
 	res, err := c.DPDKironcoreClient.DeleteFirewallRule(ctx, &dpdkproto.DeleteFirewallRuleRequest{
 		InterfaceId: []byte(interfaceID),
 		RuleId:      []byte(ruleID),
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.FirewallRule{}, err
 	}
@@ -962,13 +1133,17 @@ func (c *client) DeleteFirewallRule(ctx context.Context, interfaceID string, rul
 		Status:           api.ProtoStatusToStatus(res.Status),
 	}
 	if res.GetStatus().GetCode() != 0 {
-		return retFwrule, errors.GetError(res.Status, ignoredErrors)
+		return retFwrule, o.getError(res.Status)
 	}
 	return retFwrule, nil
 }
 
-func (c *client) CheckInitialized(ctx context.Context, ignoredErrors ...[]uint32) (*api.Initialized, error) {
-	res, err := c.DPDKironcoreClient.CheckInitialized(ctx, &dpdkproto.CheckInitializedRequest{})
+func (c *client) CheckInitialized(ctx context.Context, opts ...CallOption) (*api.Initialized, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
+	res, err := c.DPDKironcoreClient.CheckInitialized(ctx, &dpdkproto.CheckInitializedRequest{}, o.grpcOptions...)
 	if err != nil {
 		return &api.Initialized{}, err
 	}
@@ -977,14 +1152,18 @@ func (c *client) CheckInitialized(ctx context.Context, ignoredErrors ...[]uint32
 		Status:   api.ProtoStatusToStatus(res.Status),
 	}
 	if res.GetStatus().GetCode() != 0 {
-		return retInitialized, errors.GetError(res.Status, ignoredErrors)
+		return retInitialized, o.getError(res.Status)
 	}
 	retInitialized.Spec.UUID = res.Uuid
 	return retInitialized, nil
 }
 
-func (c *client) Initialize(ctx context.Context, ignoredErrors ...[]uint32) (*api.Initialized, error) {
-	res, err := c.DPDKironcoreClient.Initialize(ctx, &dpdkproto.InitializeRequest{})
+func (c *client) Initialize(ctx context.Context, opts ...CallOption) (*api.Initialized, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
+	res, err := c.DPDKironcoreClient.Initialize(ctx, &dpdkproto.InitializeRequest{}, o.grpcOptions...)
 	if err != nil {
 		return &api.Initialized{}, err
 	}
@@ -993,17 +1172,21 @@ func (c *client) Initialize(ctx context.Context, ignoredErrors ...[]uint32) (*ap
 		Status:   api.ProtoStatusToStatus(res.Status),
 	}
 	if res.GetStatus().GetCode() != 0 {
-		return retInit, errors.GetError(res.Status, ignoredErrors)
+		return retInit, o.getError(res.Status)
 	}
 	retInit.Spec.UUID = res.Uuid
 	return retInit, nil
 }
 
-func (c *client) GetVni(ctx context.Context, vni uint32, vniType uint8, ignoredErrors ...[]uint32) (*api.Vni, error) {
+func (c *client) GetVni(ctx context.Context, vni uint32, vniType uint8, opts ...CallOption) (*api.Vni, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
 	res, err := c.DPDKironcoreClient.CheckVniInUse(ctx, &dpdkproto.CheckVniInUseRequest{
 		Vni:  vni,
 		Type: dpdkproto.VniType(vniType),
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.Vni{}, err
 	}
@@ -1013,17 +1196,21 @@ func (c *client) GetVni(ctx context.Context, vni uint32, vniType uint8, ignoredE
 		Status:   api.ProtoStatusToStatus(res.Status),
 	}
 	if res.GetStatus().GetCode() != 0 {
-		return retVni, errors.GetError(res.Status, ignoredErrors)
+		return retVni, o.getError(res.Status)
 	}
 	retVni.Spec.InUse = res.InUse
 	return retVni, nil
 }
 
-func (c *client) ResetVni(ctx context.Context, vni uint32, vniType uint8, ignoredErrors ...[]uint32) (*api.Vni, error) {
+func (c *client) ResetVni(ctx context.Context, vni uint32, vniType uint8, opts ...CallOption) (*api.Vni, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
 	res, err := c.DPDKironcoreClient.ResetVni(ctx, &dpdkproto.ResetVniRequest{
 		Vni:  vni,
 		Type: dpdkproto.VniType(vniType),
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.Vni{}, err
 	}
@@ -1033,50 +1220,72 @@ func (c *client) ResetVni(ctx context.Context, vni uint32, vniType uint8, ignore
 		Status:   api.ProtoStatusToStatus(res.Status),
 	}
 	if res.GetStatus().GetCode() != 0 {
-		return retVni, errors.GetError(res.Status, ignoredErrors)
+		return retVni, o.getError(res.Status)
 	}
 	return retVni, nil
 }
 
-func (c *client) GetVersion(ctx context.Context, version *api.Version, ignoredErrors ...[]uint32) (*api.Version, error) {
+func (c *client) GetVersion(ctx context.Context, version *api.Version, opts ...CallOption) (*api.Version, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
 	version.ClientProtocol = strings.TrimSpace(dpdkproto.GeneratedFrom)
 	res, err := c.DPDKironcoreClient.GetVersion(ctx, &dpdkproto.GetVersionRequest{
 		ClientProtocol: version.ClientProtocol,
 		ClientName:     version.ClientName,
 		ClientVersion:  version.ClientVersion,
-	})
+	}, o.grpcOptions...)
 	if err != nil {
 		return &api.Version{}, err
 	}
 	version.Status = api.ProtoStatusToStatus(res.Status)
 	if res.GetStatus().GetCode() != 0 {
-		return version, errors.GetError(res.Status, ignoredErrors)
+		return version, o.getError(res.Status)
 	}
 	version.Spec.ServiceProtocol = res.ServiceProtocol
 	version.Spec.ServiceVersion = res.ServiceVersion
 	return version, nil
 }
 
-func (c *client) CaptureStart(ctx context.Context, capture *api.CaptureStart, ignoredErrors ...[]uint32) (*api.CaptureStart, error) {
-	var interfaces = make([]*dpdkproto.CapturedInterface, 0, len(capture.Spec.Interfaces))
+func (c *client) CaptureStart(ctx context.Context, capture *api.CaptureStart, opts ...CallOption) (*api.CaptureStart, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
 
-	for _, iface := range capture.Spec.Interfaces {
-		protoInterface := &dpdkproto.CapturedInterface{}
+	if err := capture.Validate(); err != nil {
+		return &api.CaptureStart{}, err
+	}
+	if !o.lenientCapture {
+		if err := capture.Spec.Validate(); err != nil {
+			return &api.CaptureStart{}, err
+		}
+	}
 
-		captureIfacetype, err := api.CaptureIfaceTypeToProtoIfaceType(iface.InterfaceType)
-		if err != nil {
-			fmt.Printf("error converting interface type for interface %s\n", iface.InterfaceInfo)
+	result := &api.CaptureStart{
+		TypeMeta:         api.TypeMeta{Kind: api.CaptureStartKind},
+		CaptureStartMeta: capture.CaptureStartMeta,
+	}
+	var interfaces = make([]*dpdkproto.CapturedInterface, 0, len(capture.Spec.Interfaces))
+	for _, iface := range capture.Spec.Interfaces {
+		if err := iface.Validate(); err != nil {
+			result.Status.SkippedInterfaces = append(result.Status.SkippedInterfaces, iface)
 			continue
 		}
 
-		protoInterface.InterfaceType = captureIfacetype
-		err = api.FillCaptureIfaceInfo(iface.InterfaceInfo, protoInterface)
+		captureIfacetype, err := api.CaptureIfaceTypeToProtoIfaceType(iface.InterfaceType)
 		if err != nil {
-			fmt.Printf("error filling interface info for interface %s\n", iface.InterfaceInfo)
-			continue
+			return &api.CaptureStart{}, err
+		}
+		protoInterface := &dpdkproto.CapturedInterface{InterfaceType: captureIfacetype}
+		if err := api.FillCaptureIfaceInfo(iface.InterfaceInfo, protoInterface); err != nil {
+			return &api.CaptureStart{}, err
 		}
-
 		interfaces = append(interfaces, protoInterface)
+		result.Spec.Interfaces = append(result.Spec.Interfaces, iface)
+	}
+	if len(interfaces) == 0 && len(result.Status.SkippedInterfaces) > 0 {
+		return &api.CaptureStart{}, capture.Spec.Validate()
 	}
 
 	res, err := c.DPDKironcoreClient.CaptureStart(ctx, &dpdkproto.CaptureStartRequest{
@@ -1086,26 +1295,30 @@ func (c *client) CaptureStart(ctx context.Context, capture *api.CaptureStart, ig
 			UdpDstPort: capture.CaptureStartMeta.Config.UdpDstPort,
 			Interfaces: interfaces,
 		},
-	})
+	}, o.grpcOptions...)
 
 	if err != nil {
 		return &api.CaptureStart{}, err
 	}
-	capture.Status = api.ProtoStatusToStatus(res.Status)
+	result.Status.Status = api.ProtoStatusToStatus(res.Status)
 	if res.GetStatus().GetCode() != 0 {
-		return capture, errors.GetError(res.Status, ignoredErrors)
+		return result, o.getError(res.Status)
 	}
 
-	return capture, nil
+	return result, nil
 }
 
-func (c *client) CaptureStop(ctx context.Context, ignoredErrors ...[]uint32) (*api.CaptureStop, error) {
-	res, err := c.DPDKironcoreClient.CaptureStop(ctx, &dpdkproto.CaptureStopRequest{})
+func (c *client) CaptureStop(ctx context.Context, opts ...CallOption) (*api.CaptureStop, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
+	res, err := c.DPDKironcoreClient.CaptureStop(ctx, &dpdkproto.CaptureStopRequest{}, o.grpcOptions...)
 	if err != nil {
 		return &api.CaptureStop{}, err
 	}
 	if res.GetStatus().GetCode() != 0 {
-		return &api.CaptureStop{}, errors.GetError(res.Status, ignoredErrors)
+		return &api.CaptureStop{}, o.getError(res.Status)
 	}
 
 	capture := &api.CaptureStop{
@@ -1118,13 +1331,17 @@ func (c *client) CaptureStop(ctx context.Context, ignoredErrors ...[]uint32) (*a
 	return capture, nil
 }
 
-func (c *client) CaptureStatus(ctx context.Context, ignoredErrors ...[]uint32) (*api.CaptureStatus, error) {
-	res, err := c.DPDKironcoreClient.CaptureStatus(ctx, &dpdkproto.CaptureStatusRequest{})
+func (c *client) CaptureStatus(ctx context.Context, opts ...CallOption) (*api.CaptureStatus, error) {
+	o := newCallOptions(opts)
+	ctx, cancel := o.context(ctx)
+	defer cancel()
+
+	res, err := c.DPDKironcoreClient.CaptureStatus(ctx, &dpdkproto.CaptureStatusRequest{}, o.grpcOptions...)
 	if err != nil {
 		return &api.CaptureStatus{}, err
 	}
 	if res.GetStatus().GetCode() != 0 {
-		return &api.CaptureStatus{}, errors.GetError(res.Status, ignoredErrors)
+		return &api.CaptureStatus{}, o.getError(res.Status)
 	}
 
 	if !res.IsActive {
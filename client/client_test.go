@@ -12,6 +12,7 @@ import (
 	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
 )
 
 const positiveTestIfaceID = "vm5"
@@ -109,6 +110,40 @@ var _ = Describe("interface", Label("interface"), func() {
 			Expect(res.Status.Code).To(Equal(uint32(errors.NOT_FOUND)))
 		})
 	})
+
+	Context("When creating baremetal interfaces", func() {
+		It("should create baremetal interfaces", func(ctx SpecContext) {
+			var types []dpdkproto.InterfaceType
+			c := newFakeClient(ctx, grpc.WithUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+				if req, ok := req.(*dpdkproto.CreateInterfaceRequest); ok {
+					types = append(types, req.GetInterfaceType())
+				}
+				return invoker(ctx, method, req, reply, cc, opts...)
+			}))
+
+			ipv4 := netip.MustParseAddr("10.200.1.4")
+			ipv6 := netip.MustParseAddr("2000:200:1::4")
+			iface, err := c.CreateInterface(ctx, &api.Interface{
+				InterfaceMeta: api.InterfaceMeta{ID: "bm1"},
+				Spec:          api.InterfaceSpec{Type: api.InterfaceTypeBareMetal, VNI: 200, Device: "net_tap5", IPv4: &ipv4, IPv6: &ipv6},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(iface.Spec.Type).To(Equal(api.InterfaceTypeBareMetal))
+			Expect(iface.Spec.VirtualFunction).To(BeNil())
+
+			ipv4 = netip.MustParseAddr("10.200.1.5")
+			ipv6 = netip.MustParseAddr("2000:200:1::5")
+			iface, err = c.CreateInterface(ctx, &api.Interface{
+				InterfaceMeta: api.InterfaceMeta{ID: "vm1"},
+				Spec:          api.InterfaceSpec{VNI: 200, Device: "net_tap6", IPv4: &ipv4, IPv6: &ipv6},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(iface.Spec.Type).To(Equal(api.InterfaceTypeVirtual))
+			Expect(iface.Spec.VirtualFunction.Name).To(Equal("net_tap6"))
+
+			Expect(types).To(Equal([]dpdkproto.InterfaceType{dpdkproto.InterfaceType_BAREMETAL, dpdkproto.InterfaceType_VIRTUAL}))
+		})
+	})
 })
 
 var _ = Describe("interface related", func() {
@@ -773,7 +808,7 @@ var _ = Describe("negative interface tests", Label("negative"), func() {
 			iface.Spec.IPv4 = nil
 			_, err := dpdkClient.CreateInterface(ctx, &iface)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid ipv4_config.primary_address"))
+			Expect(err.Error()).To(Equal("invalid Interface: spec.primary_ipv4: is required"))
 		})
 	})
 
@@ -782,7 +817,7 @@ var _ = Describe("negative interface tests", Label("negative"), func() {
 			iface.Spec.IPv6 = nil
 			_, err := dpdkClient.CreateInterface(ctx, &iface)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid ipv6_config.primary_address"))
+			Expect(err.Error()).To(Equal("invalid Interface: spec.primary_ipv6: is required"))
 		})
 	})
 
@@ -791,7 +826,7 @@ var _ = Describe("negative interface tests", Label("negative"), func() {
 			iface.Spec.Device = ""
 			_, err := dpdkClient.CreateInterface(ctx, &iface)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid device_name"))
+			Expect(err.Error()).To(Equal("invalid Interface: spec.device: is required"))
 		})
 	})
 
@@ -800,7 +835,7 @@ var _ = Describe("negative interface tests", Label("negative"), func() {
 			iface.ID = ""
 			_, err := dpdkClient.CreateInterface(ctx, &iface)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid interface_id"))
+			Expect(err.Error()).To(Equal("invalid Interface: metadata.id: is required"))
 		})
 	})
 })
@@ -849,14 +884,14 @@ var _ = Describe("negative interface related tests", Label("negative"), func() {
 
 			res, err = dpdkClient.CreatePrefix(ctx, &prefix)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid prefix.ip"))
+			Expect(err.Error()).To(Equal("invalid Prefix: spec.prefix: is required"))
 
 			By("not defining InterfaceID")
 			prefix.InterfaceID = ""
 			prefix.Spec.Prefix = netip.MustParsePrefix("10.20.30.0/24")
 			res, err = dpdkClient.CreatePrefix(ctx, &prefix)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid interface_id"))
+			Expect(err.Error()).To(Equal("invalid Prefix: metadata.interface_id: is required"))
 
 			By("using non-existent interfaceID")
 			prefix.InterfaceID = "xxx"
@@ -882,14 +917,14 @@ var _ = Describe("negative interface related tests", Label("negative"), func() {
 
 			_, err = dpdkClient.CreateLoadBalancerPrefix(ctx, &lbprefix)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid prefix.ip"))
+			Expect(err.Error()).To(Equal("invalid LoadBalancerPrefix: spec.prefix: is required"))
 
 			By("not defining InterfaceID")
 			lbprefix.InterfaceID = ""
 			lbprefix.Spec.Prefix = netip.MustParsePrefix("10.10.10.0/24")
 			_, err = dpdkClient.CreateLoadBalancerPrefix(ctx, &lbprefix)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid interface_id"))
+			Expect(err.Error()).To(Equal("invalid LoadBalancerPrefix: metadata.interface_id: is required"))
 
 			By("using non-existent interfaceID")
 			lbprefix.InterfaceID = "xxx"
@@ -914,7 +949,7 @@ var _ = Describe("negative interface related tests", Label("negative"), func() {
 			}
 			_, err = dpdkClient.CreateVirtualIP(ctx, &vip)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid vip_ip"))
+			Expect(err.Error()).To(Equal("invalid VirtualIP: spec.vip_ip: is required"))
 
 			By("not defining InterfaceID")
 			ip := netip.MustParseAddr("20.20.20.20")
@@ -922,7 +957,7 @@ var _ = Describe("negative interface related tests", Label("negative"), func() {
 			vip.InterfaceID = ""
 			_, err = dpdkClient.CreateVirtualIP(ctx, &vip)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid interface_id"))
+			Expect(err.Error()).To(Equal("invalid VirtualIP: metadata.interface_id: is required"))
 
 			By("using non-existent interfaceID")
 			vip.InterfaceID = "xxx"
@@ -951,7 +986,7 @@ var _ = Describe("negative interface related tests", Label("negative"), func() {
 
 			_, err = dpdkClient.CreateNat(ctx, &nat)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid port range"))
+			Expect(err.Error()).To(Equal("invalid Nat: spec.max_port: must be greater than min_port"))
 
 			By("not defining InterfaceID")
 			nat.Spec.MinPort = 30000
@@ -959,27 +994,27 @@ var _ = Describe("negative interface related tests", Label("negative"), func() {
 			nat.NatMeta = api.NatMeta{}
 			_, err = dpdkClient.CreateNat(ctx, &nat)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid interface_id"))
+			Expect(err.Error()).To(Equal("invalid Nat: metadata.interface_id: is required"))
 
 			By("MaxPort out of range")
 			nat.Spec.MaxPort = 75000
 			nat.InterfaceID = negativeTestIfaceID
 			_, err = dpdkClient.CreateNat(ctx, &nat)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid max_port"))
+			Expect(err.Error()).To(Equal("invalid Nat: spec.max_port: must be at most 65535"))
 
 			By("MaxPort < MinPort")
 			nat.Spec.MinPort = 31000
 			nat.Spec.MaxPort = 30000
 			_, err = dpdkClient.CreateNat(ctx, &nat)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid port range"))
+			Expect(err.Error()).To(Equal("invalid Nat: spec.max_port: must be greater than min_port"))
 
 			By("not defining IP")
 			nat.Spec.NatIP = nil
 			_, err = dpdkClient.CreateNat(ctx, &nat)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid nat_ip"))
+			Expect(err.Error()).To(Equal("invalid Nat: spec.nat_ip: is required, spec.max_port: must be greater than min_port"))
 
 			By("using non-existent interfaceID")
 			nat.Spec.NatIP = &ip
@@ -1013,13 +1048,13 @@ var _ = Describe("negative interface related tests", Label("negative"), func() {
 
 			_, err = dpdkClient.CreateNeighborNat(ctx, &neighborNat)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid nat_ip"))
+			Expect(err.Error()).To(Equal("invalid NeighborNat: metadata.nat_ip: is required"))
 
 			By("not defining UnderlayRoute")
 			neighborNat.Spec.UnderlayRoute = nil
 			_, err = dpdkClient.CreateNeighborNat(ctx, &neighborNat)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("underlayRoute needs to be specified"))
+			Expect(err.Error()).To(Equal("invalid NeighborNat: metadata.nat_ip: is required, spec.underlay_route: is required"))
 
 			By("MaxPort < MinPort")
 			natIp := netip.MustParseAddr("10.20.30.40")
@@ -1029,13 +1064,13 @@ var _ = Describe("negative interface related tests", Label("negative"), func() {
 			neighborNat.Spec.UnderlayRoute = &underlayRoute
 			_, err = dpdkClient.CreateNeighborNat(ctx, &neighborNat)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid port range"))
+			Expect(err.Error()).To(Equal("invalid NeighborNat: spec.max_port: must be greater than min_port"))
 
 			By("not defining Spec")
 			neighborNat.Spec = api.NeighborNatSpec{}
 			_, err = dpdkClient.CreateNeighborNat(ctx, &neighborNat)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("underlayRoute needs to be specified"))
+			Expect(err.Error()).To(Equal("invalid NeighborNat: spec.max_port: must be greater than min_port, spec.underlay_route: is required"))
 		})
 	})
 
@@ -1067,20 +1102,20 @@ var _ = Describe("negative interface related tests", Label("negative"), func() {
 			route.Spec.Prefix = nil
 			_, err = dpdkClient.CreateRoute(ctx, &route)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("prefix needs to be specified"))
+			Expect(err.Error()).To(Equal("invalid Route: spec.prefix: is required"))
 
 			By("not defining nexthop ip")
 			route.Spec.Prefix = &prefix
 			route.Spec.NextHop.IP = nil
 			_, err = dpdkClient.CreateRoute(ctx, &route)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid route.nexthop_address"))
+			Expect(err.Error()).To(Equal("invalid Route: spec.next_hop.address: is required"))
 
 			By("not defining nexthop")
 			route.Spec.NextHop = nil
 			_, err = dpdkClient.CreateRoute(ctx, &route)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("nextHop needs to be specified"))
+			Expect(err.Error()).To(Equal("invalid Route: spec.next_hop: is required"))
 		})
 	})
 
@@ -1116,42 +1151,42 @@ var _ = Describe("negative interface related tests", Label("negative"), func() {
 
 			_, err = dpdkClient.CreateFirewallRule(ctx, &fwRule)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid interface_id"))
+			Expect(err.Error()).To(Equal("invalid FirewallRule: metadata.interface_id: is required"))
 
 			By("empty ruleID")
 			fwRule.InterfaceID = negativeTestIfaceID
 			fwRule.Spec.RuleID = ""
 			_, err = dpdkClient.CreateFirewallRule(ctx, &fwRule)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid rule id"))
+			Expect(err.Error()).To(Equal("invalid FirewallRule: spec.id: is required"))
 
 			By("wrong traffic direction")
 			fwRule.Spec.RuleID = "Rule1"
 			fwRule.Spec.TrafficDirection = "xxx"
 			_, err = dpdkClient.CreateFirewallRule(ctx, &fwRule)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("traffic direction can be only: Ingress = 0/Egress = 1"))
+			Expect(err.Error()).To(Equal("invalid FirewallRule: spec.direction: must be Ingress or Egress"))
 
 			By("wrong fw action")
 			fwRule.Spec.TrafficDirection = "ingress"
 			fwRule.Spec.FirewallAction = "xxx"
 			_, err = dpdkClient.CreateFirewallRule(ctx, &fwRule)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("firewall action can be only: drop/deny/0|accept/allow/1"))
+			Expect(err.Error()).To(Equal("invalid FirewallRule: spec.action: must be Accept or Drop"))
 
 			By("not defining src prefix")
 			fwRule.Spec.FirewallAction = "accept"
 			fwRule.Spec.SourcePrefix = nil
 			_, err = dpdkClient.CreateFirewallRule(ctx, &fwRule)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("source prefix needs to be specified"))
+			Expect(err.Error()).To(Equal("invalid FirewallRule: spec.source_prefix: is required"))
 
 			By("not defining dst prefix")
 			fwRule.Spec.SourcePrefix = &src
 			fwRule.Spec.DestinationPrefix = nil
 			_, err = dpdkClient.CreateFirewallRule(ctx, &fwRule)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("destination prefix needs to be specified"))
+			Expect(err.Error()).To(Equal("invalid FirewallRule: spec.destination_prefix: is required"))
 
 			By("srcportlower out of range")
 			fwRule.Spec.DestinationPrefix = &dst
@@ -1162,7 +1197,7 @@ var _ = Describe("negative interface related tests", Label("negative"), func() {
 			}
 			_, err = dpdkClient.CreateFirewallRule(ctx, &fwRule)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid tcp.src_port_lower"))
+			Expect(err.Error()).To(Equal("invalid FirewallRule: spec.protocol_filter.tcp.src_port_lower: must be between -1 and 65535"))
 
 			By("srcportupper out of range")
 			fwRule.Spec.ProtocolFilter.Filter = &dpdkproto.ProtocolFilter_Tcp{
@@ -1172,7 +1207,7 @@ var _ = Describe("negative interface related tests", Label("negative"), func() {
 			}
 			_, err = dpdkClient.CreateFirewallRule(ctx, &fwRule)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid tcp.src_port_upper"))
+			Expect(err.Error()).To(Equal("invalid FirewallRule: spec.protocol_filter.tcp.src_port_upper: must be between -1 and 65535"))
 
 			By("dstportupper > dstportlower")
 			fwRule.Spec.ProtocolFilter.Filter = &dpdkproto.ProtocolFilter_Udp{
@@ -1183,7 +1218,7 @@ var _ = Describe("negative interface related tests", Label("negative"), func() {
 			}
 			_, err = dpdkClient.CreateFirewallRule(ctx, &fwRule)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid udp.dst_port range"))
+			Expect(err.Error()).To(Equal("invalid FirewallRule: spec.protocol_filter.udp.dst_port_upper: must not be lower than the lower port"))
 
 			By("icmpType out of range")
 			fwRule.Spec.ProtocolFilter.Filter = &dpdkproto.ProtocolFilter_Icmp{
@@ -1193,7 +1228,7 @@ var _ = Describe("negative interface related tests", Label("negative"), func() {
 			}
 			_, err = dpdkClient.CreateFirewallRule(ctx, &fwRule)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid icmp.icmp_type"))
+			Expect(err.Error()).To(Equal("invalid FirewallRule: spec.protocol_filter.icmp.icmp_type: must be between -1 and 255"))
 
 			By("icmpCode out of range")
 			fwRule.Spec.ProtocolFilter.Filter = &dpdkproto.ProtocolFilter_Icmp{
@@ -1203,13 +1238,13 @@ var _ = Describe("negative interface related tests", Label("negative"), func() {
 			}
 			_, err = dpdkClient.CreateFirewallRule(ctx, &fwRule)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid icmp.icmp_code"))
+			Expect(err.Error()).To(Equal("invalid FirewallRule: spec.protocol_filter.icmp.icmp_code: must be between -1 and 255"))
 
 			By("not defining spec")
 			fwRule.Spec = api.FirewallRuleSpec{}
 			_, err = dpdkClient.CreateFirewallRule(ctx, &fwRule)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("firewall action can be only: drop/deny/0|accept/allow/1"))
+			Expect(err.Error()).To(Equal("invalid FirewallRule: spec.id: is required, spec.direction: must be Ingress or Egress, spec.action: must be Accept or Drop, spec.source_prefix: is required, spec.destination_prefix: is required"))
 		})
 	})
 
@@ -1248,7 +1283,7 @@ var _ = Describe("negative interface related tests", Label("negative"), func() {
 			_, err := dpdkClient.CaptureStart(ctx, &captureStart)
 
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid sink_node_ip"))
+			Expect(err.Error()).To(Equal("invalid CaptureStart: metadata.capture_config.sink_node_ipv6: is required"))
 
 			By("using ipv4 sink node")
 			addr := netip.MustParseAddr("10.0.0.1")
@@ -1256,14 +1291,14 @@ var _ = Describe("negative interface related tests", Label("negative"), func() {
 			_, err = dpdkClient.CaptureStart(ctx, &captureStart)
 
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid sink_node_ip"))
+			Expect(err.Error()).To(Equal("invalid CaptureStart: metadata.capture_config.sink_node_ipv6: must be an IPv6 address"))
 
 			By("not defining capture config")
 			captureStart.Config = &api.CaptureConfig{}
 			_, err = dpdkClient.CaptureStart(ctx, &captureStart)
 
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid sink_node_ip"))
+			Expect(err.Error()).To(Equal("invalid CaptureStart: metadata.capture_config.sink_node_ipv6: is required"))
 
 			By("src port out of range")
 			captureStart.Config.SinkNodeIP = &sinkNode
@@ -1272,7 +1307,7 @@ var _ = Describe("negative interface related tests", Label("negative"), func() {
 			_, err = dpdkClient.CaptureStart(ctx, &captureStart)
 
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid udp_src_port"))
+			Expect(err.Error()).To(Equal("invalid CaptureStart: metadata.capture_config.udp_src_port: must be at most 65535"))
 
 			By("dst port out of range")
 			captureStart.CaptureStartMeta.Config.UdpSrcPort = 500
@@ -1281,7 +1316,7 @@ var _ = Describe("negative interface related tests", Label("negative"), func() {
 			_, err = dpdkClient.CaptureStart(ctx, &captureStart)
 
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = Invalid udp_dst_port"))
+			Expect(err.Error()).To(Equal("invalid CaptureStart: metadata.capture_config.udp_dst_port: must be at most 65535"))
 
 			By("stopping when no capture is running")
 			_, err = dpdkClient.CaptureStop(ctx)
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/errors"
+)
+
+// addInterfaceDetails fills in the details requested by WithDetails.
+func (c *client) addInterfaceDetails(ctx context.Context, iface *api.Interface, o *callOptions) error {
+	opts := []CallOption{WithCallOptions(o.grpcOptions...), IgnoreErrors(errors.SNAT_NO_DATA)}
+
+	nat, err := c.GetNat(ctx, iface.ID, opts...)
+	if err != nil {
+		return fmt.Errorf("error getting nat of interface %s: %w", iface.ID, err)
+	}
+	if nat.Status.Code == 0 {
+		iface.Spec.Nat = nat
+	}
+
+	vip, err := c.GetVirtualIP(ctx, iface.ID, opts...)
+	if err != nil {
+		return fmt.Errorf("error getting virtual ip of interface %s: %w", iface.ID, err)
+	}
+	if vip.Status.Code == 0 {
+		iface.Spec.VIP = vip
+	}
+
+	prefixes, err := checkList(c.ListPrefixes(ctx, iface.ID, opts...))
+	if err != nil {
+		return fmt.Errorf("error listing prefixes of interface %s: %w", iface.ID, err)
+	}
+	iface.Spec.Prefixes = prefixes.Items
+
+	rules, err := checkList(c.ListFirewallRules(ctx, iface.ID, opts...))
+	if err != nil {
+		return fmt.Errorf("error listing firewall rules of interface %s: %w", iface.ID, err)
+	}
+	iface.Spec.FirewallRules = rules.Items
+	return nil
+}
+
+// addInterfacesDetails calls addInterfaceDetails for ifaces with DefaultBatchWorkers concurrent calls.
+// The first failure cancels the calls in flight and the remaining interfaces are skipped.
+func (c *client) addInterfacesDetails(ctx context.Context, ifaces []api.Interface, o *callOptions) error {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	runBatch(ctx, pointers(ifaces), newBatchOptions(nil),
+		func(ctx context.Context, iface *api.Interface) (*api.Interface, error) {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			if err := c.addInterfaceDetails(ctx, iface, o); err != nil {
+				cancel(err)
+				return nil, err
+			}
+			return iface, nil
+		}, nil)
+	return context.Cause(ctx)
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sync/atomic"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/errors"
+	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("interface details", Label("details"), func() {
+	var c Client
+
+	BeforeEach(func(ctx SpecContext) {
+		c = newFakeClient(ctx)
+
+		anyPrefix := netip.MustParsePrefix("0.0.0.0/0")
+		_, err := AttachVM(ctx, c, VMNetworkSpec{
+			ID: "vm1",
+			Interface: api.InterfaceSpec{
+				VNI:    100,
+				Device: "net_tap2",
+				IPv4:   ptrAddr("10.200.1.4"),
+				IPv6:   ptrAddr("2000:200:1::4"),
+			},
+			Prefixes: []netip.Prefix{netip.MustParsePrefix("10.10.0.0/24")},
+			Nat:      &api.NatSpec{NatIP: ptrAddr("20.10.0.1"), MinPort: 1024, MaxPort: 2048},
+			FirewallRules: []api.FirewallRuleSpec{{
+				RuleID:            "fr1",
+				TrafficDirection:  "ingress",
+				FirewallAction:    "accept",
+				Priority:          1000,
+				SourcePrefix:      &anyPrefix,
+				DestinationPrefix: &anyPrefix,
+			}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should only fill in details if requested", func(ctx SpecContext) {
+		iface, err := c.GetInterface(ctx, "vm1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(iface.Spec.VirtualFunction).To(BeNil())
+		Expect(iface.Spec.Nat).To(BeNil())
+
+		iface, err = c.GetInterface(ctx, "vm1", WithDetails())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(iface.Spec.VirtualFunction).To(BeNil())
+		Expect(iface.Spec.Nat.Spec.NatIP.String()).To(Equal("20.10.0.1"))
+		Expect(iface.Spec.VIP).To(BeNil())
+		Expect(iface.Spec.Prefixes).To(HaveLen(1))
+		Expect(iface.Spec.Prefixes[0].Spec.Prefix.String()).To(Equal("10.10.0.0/24"))
+		Expect(iface.Spec.FirewallRules).To(HaveLen(1))
+		Expect(iface.Spec.FirewallRules[0].Spec.RuleID).To(Equal("fr1"))
+	})
+
+	It("should fill in the details of every listed interface", func(ctx SpecContext) {
+		for i := 2; i <= 20; i++ {
+			_, err := AttachVM(ctx, c, VMNetworkSpec{
+				ID: fmt.Sprintf("vm%d", i),
+				Interface: api.InterfaceSpec{
+					VNI:    100,
+					Device: fmt.Sprintf("net_tap%d", i+1),
+					IPv4:   ptrAddr(fmt.Sprintf("10.200.1.%d", i+3)),
+					IPv6:   ptrAddr(fmt.Sprintf("2000:200:1::%d", i+3)),
+				},
+				VirtualIP: ptrAddr(fmt.Sprintf("20.20.0.%d", i)),
+			})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		list, err := c.ListInterfaces(ctx, WithDetails())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Items).To(HaveLen(20))
+		for _, iface := range list.Items {
+			if iface.ID == "vm1" {
+				Expect(iface.Spec.Nat).NotTo(BeNil())
+				continue
+			}
+			Expect(iface.Spec.VIP).NotTo(BeNil())
+			Expect(iface.Spec.Prefixes).To(BeEmpty())
+		}
+	})
+
+	It("should stop filling in details after the first failure", func(ctx SpecContext) {
+		var natCalls atomic.Int32
+		failing := newFakeClient(ctx, grpc.WithUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			if _, ok := req.(*dpdkproto.GetNatRequest); ok {
+				natCalls.Add(1)
+				return status.Error(codes.Internal, "nat lookup failed")
+			}
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}))
+		for i := 1; i <= 20; i++ {
+			_, err := failing.CreateInterface(ctx, &api.Interface{
+				InterfaceMeta: api.InterfaceMeta{ID: fmt.Sprintf("vm%d", i)},
+				Spec: api.InterfaceSpec{
+					VNI:    100,
+					Device: fmt.Sprintf("net_tap%d", i+1),
+					IPv4:   ptrAddr(fmt.Sprintf("10.200.1.%d", i+3)),
+					IPv6:   ptrAddr(fmt.Sprintf("2000:200:1::%d", i+3)),
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		_, err := failing.ListInterfaces(ctx, WithDetails())
+		Expect(err).To(MatchError(ContainSubstring("nat lookup failed")))
+		Expect(natCalls.Load()).To(BeNumerically("<=", DefaultBatchWorkers))
+	})
+
+	It("should fail if a detail list returns a status", func(ctx SpecContext) {
+		failing := newFakeClient(ctx, grpc.WithUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			if res, ok := reply.(*dpdkproto.ListPrefixesResponse); ok {
+				res.Status = &dpdkproto.Status{Code: errors.NO_VM, Message: "listing failed"}
+				return nil
+			}
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}))
+		_, err := failing.CreateInterface(ctx, &api.Interface{
+			InterfaceMeta: api.InterfaceMeta{ID: "vm1"},
+			Spec: api.InterfaceSpec{
+				VNI:    100,
+				Device: "net_tap2",
+				IPv4:   ptrAddr("10.200.1.4"),
+				IPv6:   ptrAddr("2000:200:1::4"),
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = failing.GetInterface(ctx, "vm1", WithDetails())
+		Expect(errors.IsStatusErrorCode(err, errors.NO_VM)).To(BeTrue())
+	})
+})
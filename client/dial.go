@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+)
+
+type options struct {
+	creds              credentials.TransportCredentials
+	keepalive          *keepalive.ClientParameters
+	defaultCallTimeout time.Duration
+	retryPolicy        *RetryPolicy
+	userAgent          string
+	block              bool
+	dialOptions        []grpc.DialOption
+	log                logr.Logger
+	callLogLevel       int
+	ignoredLogLevel    int
+	err                error
+}
+
+// Option configures how Dial connects to dp-service. NewClient only uses WithLogger and WithLogLevels.
+type Option func(*options)
+
+func newOptions(opts []Option) *options {
+	o := &options{
+		creds:           insecure.NewCredentials(),
+		block:           true,
+		callLogLevel:    1,
+		ignoredLogLevel: 2,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithTransportCredentials sets the transport credentials used for the connection.
+// By default the connection is insecure.
+func WithTransportCredentials(creds credentials.TransportCredentials) Option {
+	return func(o *options) {
+		o.creds = creds
+	}
+}
+
+// WithTLSConfig secures the connection with the given TLS configuration.
+// Setting Certificates in the configuration enables mutual TLS.
+func WithTLSConfig(config *tls.Config) Option {
+	return WithTransportCredentials(credentials.NewTLS(config))
+}
+
+// WithTLSFromFiles secures the connection using PEM encoded files.
+// caFile is used to verify the server, if empty the system roots are used.
+// If certFile and keyFile are set, the client authenticates itself with them (mutual TLS).
+func WithTLSFromFiles(caFile, certFile, keyFile string) Option {
+	return func(o *options) {
+		config := &tls.Config{MinVersion: tls.VersionTLS12}
+
+		if caFile != "" {
+			ca, err := os.ReadFile(caFile)
+			if err != nil {
+				o.err = fmt.Errorf("error reading ca file: %w", err)
+				return
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(ca) {
+				o.err = fmt.Errorf("error parsing ca file %s", caFile)
+				return
+			}
+			config.RootCAs = pool
+		}
+
+		if certFile != "" || keyFile != "" {
+			cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+			if err != nil {
+				o.err = fmt.Errorf("error loading client certificate: %w", err)
+				return
+			}
+			config.Certificates = []tls.Certificate{cert}
+		}
+
+		o.creds = credentials.NewTLS(config)
+	}
+}
+
+// WithKeepalive sends keepalive pings after interval of inactivity and
+// closes the connection if no ack is received within timeout.
+func WithKeepalive(interval, timeout time.Duration) Option {
+	return func(o *options) {
+		o.keepalive = &keepalive.ClientParameters{
+			Time:                interval,
+			Timeout:             timeout,
+			PermitWithoutStream: true,
+		}
+	}
+}
+
+// WithDefaultCallTimeout applies timeout to every call whose context has no deadline.
+func WithDefaultCallTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.defaultCallTimeout = timeout
+	}
+}
+
+// WithUserAgent sets the user agent sent to dp-service.
+func WithUserAgent(userAgent string) Option {
+	return func(o *options) {
+		o.userAgent = userAgent
+	}
+}
+
+// WithNonBlocking makes Dial return immediately instead of waiting for the connection to be ready.
+func WithNonBlocking() Option {
+	return func(o *options) {
+		o.block = false
+	}
+}
+
+// WithDialOptions passes additional options to grpc.DialContext.
+func WithDialOptions(dialOptions ...grpc.DialOption) Option {
+	return func(o *options) {
+		o.dialOptions = append(o.dialOptions, dialOptions...)
+	}
+}
+
+// WithLogger logs every mutating call with a summary of the request and the status returned by dp-service,
+// and every status code ignored with IgnoreErrors. By default nothing is logged.
+func WithLogger(log logr.Logger) Option {
+	return func(o *options) {
+		o.log = log
+	}
+}
+
+// WithLogLevels sets the verbosity of the logs of mutating calls and of ignored status codes, 1 and 2 by default.
+func WithLogLevels(calls, ignoredErrors int) Option {
+	return func(o *options) {
+		o.callLogLevel = calls
+		o.ignoredLogLevel = ignoredErrors
+	}
+}
+
+// Dial connects to dp-service at addr and returns a Client owning the connection.
+// addr is either host:port, a gRPC target such as unix:///run/dpservice.sock or
+// an absolute path to a unix socket. Unless WithNonBlocking is given, Dial
+// blocks until the connection is ready or ctx is done.
+// The returned client has to be closed to release the connection.
+func Dial(ctx context.Context, addr string, opts ...Option) (Client, error) {
+	o := newOptions(opts)
+	if o.err != nil {
+		return nil, o.err
+	}
+
+	if strings.HasPrefix(addr, "/") {
+		addr = "unix://" + addr
+	}
+
+	dialOptions := []grpc.DialOption{grpc.WithTransportCredentials(o.creds)}
+	if o.keepalive != nil {
+		dialOptions = append(dialOptions, grpc.WithKeepaliveParams(*o.keepalive))
+	}
+	// Retries wrap the default call timeout, so every attempt gets its own timeout.
+	if o.retryPolicy != nil {
+		dialOptions = append(dialOptions, grpc.WithChainUnaryInterceptor(RetryInterceptor(*o.retryPolicy)))
+	}
+	if o.defaultCallTimeout > 0 {
+		dialOptions = append(dialOptions, grpc.WithChainUnaryInterceptor(defaultCallTimeoutInterceptor(o.defaultCallTimeout)))
+	}
+	if o.userAgent != "" {
+		dialOptions = append(dialOptions, grpc.WithUserAgent(o.userAgent))
+	}
+	if o.block {
+		dialOptions = append(dialOptions, grpc.WithBlock())
+	}
+	dialOptions = append(dialOptions, o.dialOptions...)
+
+	conn, err := grpc.DialContext(ctx, addr, dialOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to dpservice %s: %w", addr, err)
+	}
+
+	return newClient(dpdkproto.NewDPDKironcoreClient(conn), conn, o), nil
+}
+
+func defaultCallTimeoutInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"time"
+
+	"github.com/ironcore-dev/dpservice-go/dpservicetest"
+	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+var _ = Describe("dial", Label("dial"), func() {
+	var socket string
+	var userAgent chan string
+
+	BeforeEach(func() {
+		socket = filepath.Join(GinkgoT().TempDir(), "dpservice.sock")
+		lis, err := net.Listen("unix", socket)
+		Expect(err).NotTo(HaveOccurred())
+
+		userAgent = make(chan string, 1)
+		srv := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get("user-agent")) > 0 {
+				select {
+				case userAgent <- md.Get("user-agent")[0]:
+				default:
+				}
+			}
+			return handler(ctx, req)
+		}))
+		dpdkproto.RegisterDPDKironcoreServer(srv, dpservicetest.NewServer())
+		go func() {
+			_ = srv.Serve(lis)
+		}()
+		DeferCleanup(srv.Stop)
+	})
+
+	It("should connect to a unix socket path", func(ctx SpecContext) {
+		c, err := Dial(ctx, socket,
+			WithUserAgent("dial-test"),
+			WithKeepalive(30*time.Second, 5*time.Second),
+			WithDefaultCallTimeout(time.Second),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(c.Close)
+
+		init, err := c.Initialize(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(init.Spec.UUID).NotTo(BeEmpty())
+		Expect(<-userAgent).To(HavePrefix("dial-test"))
+	})
+
+	It("should connect to a unix target", func(ctx SpecContext) {
+		c, err := Dial(ctx, "unix://"+socket)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Close()).To(Succeed())
+	})
+
+	It("should fail when the tls files cannot be loaded", func(ctx SpecContext) {
+		_, err := Dial(ctx, socket, WithTLSFromFiles(filepath.Join(GinkgoT().TempDir(), "ca.pem"), "", ""))
+		Expect(err).To(MatchError(ContainSubstring("error reading ca file")))
+	})
+})
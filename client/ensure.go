@@ -0,0 +1,491 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/errors"
+	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// Mismatch is a field whose existing value differs from the desired one.
+type Mismatch struct {
+	Field    string
+	Existing string
+	Desired  string
+}
+
+// ConflictError is returned by the Ensure functions if the object already exists with a different spec.
+type ConflictError struct {
+	Kind       string
+	Name       string
+	Mismatches []Mismatch
+}
+
+func (e *ConflictError) Error() string {
+	mismatches := make([]string, len(e.Mismatches))
+	for i, m := range e.Mismatches {
+		mismatches[i] = fmt.Sprintf("%s is %s, desired %s", m.Field, m.Existing, m.Desired)
+	}
+	return fmt.Sprintf("%s %s already exists with a different spec: %s", e.Kind, e.Name, strings.Join(mismatches, ", "))
+}
+
+type ensureOptions struct {
+	recreate    bool
+	callOptions []CallOption
+}
+
+// EnsureOption configures the Ensure functions.
+type EnsureOption func(*ensureOptions)
+
+// WithRecreate deletes and recreates an existing object that differs from the desired one
+// instead of returning a ConflictError.
+func WithRecreate() EnsureOption {
+	return func(o *ensureOptions) {
+		o.recreate = true
+	}
+}
+
+// WithEnsureCallOptions passes opts to every call made by the Ensure function.
+func WithEnsureCallOptions(opts ...CallOption) EnsureOption {
+	return func(o *ensureOptions) {
+		o.callOptions = append(o.callOptions, opts...)
+	}
+}
+
+func newEnsureOptions(opts []EnsureOption) *ensureOptions {
+	o := &ensureOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// ensurer implements the common flow of the Ensure functions for one object.
+type ensurer[T api.Object] struct {
+	// existsCodes are the status codes dp-service returns if the object already exists.
+	existsCodes []uint32
+	create      func() (T, error)
+	// get returns the existing object, ok is false if it cannot be found.
+	get     func() (cur T, ok bool, err error)
+	compare func(cur T, d *differ)
+	delete  func() error
+}
+
+// ensure creates the object. If it already exists, the existing object is returned if it
+// matches, otherwise it is recreated or a ConflictError is returned.
+func (e *ensurer[T]) ensure(o *ensureOptions) (T, error) {
+	res, err := e.create()
+	if err == nil || !errors.IsStatusErrorCode(err, e.existsCodes...) {
+		return res, err
+	}
+	createErr := err
+
+	cur, ok, err := e.get()
+	if err != nil {
+		return res, fmt.Errorf("error getting existing %s %s: %w", res.GetKind(), res.GetName(), err)
+	}
+	if !ok {
+		// Something else, e.g. another interface with the same IP, caused the conflict.
+		return res, createErr
+	}
+
+	d := &differ{}
+	if e.compare != nil {
+		e.compare(cur, d)
+	}
+	if len(d.mismatches) == 0 {
+		return cur, nil
+	}
+	if !o.recreate {
+		return cur, &ConflictError{Kind: cur.GetKind(), Name: cur.GetName(), Mismatches: d.mismatches}
+	}
+
+	if err := e.delete(); err != nil {
+		return cur, fmt.Errorf("error deleting %s %s to recreate it: %w", cur.GetKind(), cur.GetName(), err)
+	}
+	return e.create()
+}
+
+type differ struct {
+	mismatches []Mismatch
+}
+
+func (d *differ) add(field string, existing, desired string) {
+	d.mismatches = append(d.mismatches, Mismatch{Field: field, Existing: existing, Desired: desired})
+}
+
+func (d *differ) uint32(field string, existing, desired uint32) {
+	if existing != desired {
+		d.add(field, strconv.FormatUint(uint64(existing), 10), strconv.FormatUint(uint64(desired), 10))
+	}
+}
+
+func (d *differ) string(field string, existing, desired string) {
+	if !strings.EqualFold(existing, desired) {
+		d.add(field, strconv.Quote(existing), strconv.Quote(desired))
+	}
+}
+
+func (d *differ) addr(field string, existing, desired *netip.Addr) {
+	var x, y netip.Addr
+	if existing != nil {
+		x = *existing
+	}
+	if desired != nil {
+		y = *desired
+	}
+	if x != y {
+		d.add(field, formatAddr(x), formatAddr(y))
+	}
+}
+
+func (d *differ) prefix(field string, existing, desired *netip.Prefix) {
+	var x, y netip.Prefix
+	if existing != nil {
+		x = existing.Masked()
+	}
+	if desired != nil {
+		y = desired.Masked()
+	}
+	if x != y {
+		d.add(field, x.String(), y.String())
+	}
+}
+
+func formatAddr(addr netip.Addr) string {
+	if !addr.IsValid() {
+		return "unset"
+	}
+	return addr.String()
+}
+
+func formatLbports(ports []api.LBPort) string {
+	s := make([]string, len(ports))
+	for i, port := range ports {
+		s[i] = fmt.Sprintf("%s/%d", strings.ToLower(dpdkproto.Protocol(port.Protocol).String()), port.Port)
+	}
+	sort.Strings(s)
+	return "[" + strings.Join(s, " ") + "]"
+}
+
+// EnsureInterface creates iface or returns the existing interface with the same ID
+// if its VNI, device and primary IPs match.
+func EnsureInterface(ctx context.Context, c Client, iface *api.Interface, opts ...EnsureOption) (*api.Interface, error) {
+	o := newEnsureOptions(opts)
+	e := &ensurer[*api.Interface]{
+		existsCodes: []uint32{errors.ALREADY_EXISTS},
+		create: func() (*api.Interface, error) {
+			return c.CreateInterface(ctx, iface, o.callOptions...)
+		},
+		get: func() (*api.Interface, bool, error) {
+			cur, err := c.GetInterface(ctx, iface.ID, o.callOptions...)
+			if errors.IsStatusErrorCode(err, errors.NOT_FOUND) {
+				return nil, false, nil
+			}
+			return cur, err == nil, err
+		},
+		compare: func(cur *api.Interface, d *differ) {
+			d.uint32("spec.vni", cur.Spec.VNI, iface.Spec.VNI)
+			d.string("spec.device", cur.Spec.Device, iface.Spec.Device)
+			d.addr("spec.primary_ipv4", cur.Spec.IPv4, iface.Spec.IPv4)
+			d.addr("spec.primary_ipv6", cur.Spec.IPv6, iface.Spec.IPv6)
+		},
+		delete: func() error {
+			_, err := c.DeleteInterface(ctx, iface.ID, o.callOptions...)
+			return err
+		},
+	}
+	return e.ensure(o)
+}
+
+// EnsurePrefix creates prefix or returns the existing one.
+func EnsurePrefix(ctx context.Context, c Client, prefix *api.Prefix, opts ...EnsureOption) (*api.Prefix, error) {
+	o := newEnsureOptions(opts)
+	e := &ensurer[*api.Prefix]{
+		existsCodes: []uint32{errors.ALREADY_EXISTS, errors.ROUTE_EXISTS},
+		create: func() (*api.Prefix, error) {
+			return c.CreatePrefix(ctx, prefix, o.callOptions...)
+		},
+		get: func() (*api.Prefix, bool, error) {
+			list, err := c.ListPrefixes(ctx, prefix.InterfaceID, o.callOptions...)
+			if err != nil {
+				return nil, false, err
+			}
+			for i := range list.Items {
+				if list.Items[i].Spec.Prefix.Masked() == prefix.Spec.Prefix.Masked() {
+					return &list.Items[i], true, nil
+				}
+			}
+			return nil, false, nil
+		},
+	}
+	return e.ensure(o)
+}
+
+// EnsureLoadBalancerPrefix creates prefix or returns the existing one.
+func EnsureLoadBalancerPrefix(ctx context.Context, c Client, prefix *api.LoadBalancerPrefix, opts ...EnsureOption) (*api.LoadBalancerPrefix, error) {
+	o := newEnsureOptions(opts)
+	e := &ensurer[*api.LoadBalancerPrefix]{
+		existsCodes: []uint32{errors.ALREADY_EXISTS, errors.ROUTE_EXISTS},
+		create: func() (*api.LoadBalancerPrefix, error) {
+			return c.CreateLoadBalancerPrefix(ctx, prefix, o.callOptions...)
+		},
+		get: func() (*api.LoadBalancerPrefix, bool, error) {
+			list, err := c.ListLoadBalancerPrefixes(ctx, prefix.InterfaceID, o.callOptions...)
+			if err != nil {
+				return nil, false, err
+			}
+			for _, item := range list.Items {
+				if item.Spec.Prefix.Masked() == prefix.Spec.Prefix.Masked() {
+					return &api.LoadBalancerPrefix{
+						TypeMeta:               api.TypeMeta{Kind: api.LoadBalancerPrefixKind},
+						LoadBalancerPrefixMeta: api.LoadBalancerPrefixMeta{InterfaceID: item.InterfaceID},
+						Spec:                   api.LoadBalancerPrefixSpec{Prefix: item.Spec.Prefix, UnderlayRoute: item.Spec.UnderlayRoute},
+						Status:                 item.Status,
+					}, true, nil
+				}
+			}
+			return nil, false, nil
+		},
+	}
+	return e.ensure(o)
+}
+
+// EnsureVirtualIP creates vip or returns the existing virtual IP of the interface if the IP matches.
+func EnsureVirtualIP(ctx context.Context, c Client, vip *api.VirtualIP, opts ...EnsureOption) (*api.VirtualIP, error) {
+	o := newEnsureOptions(opts)
+	e := &ensurer[*api.VirtualIP]{
+		existsCodes: []uint32{errors.ALREADY_EXISTS, errors.SNAT_EXISTS},
+		create: func() (*api.VirtualIP, error) {
+			return c.CreateVirtualIP(ctx, vip, o.callOptions...)
+		},
+		get: func() (*api.VirtualIP, bool, error) {
+			cur, err := c.GetVirtualIP(ctx, vip.InterfaceID, o.callOptions...)
+			if errors.IsStatusErrorCode(err, errors.SNAT_NO_DATA, errors.NOT_FOUND) {
+				return nil, false, nil
+			}
+			return cur, err == nil, err
+		},
+		compare: func(cur *api.VirtualIP, d *differ) {
+			d.addr("spec.vip_ip", cur.Spec.IP, vip.Spec.IP)
+		},
+		delete: func() error {
+			_, err := c.DeleteVirtualIP(ctx, vip.InterfaceID, o.callOptions...)
+			return err
+		},
+	}
+	return e.ensure(o)
+}
+
+// EnsureNat creates nat or returns the existing NAT of the interface if the NAT IP and ports match.
+func EnsureNat(ctx context.Context, c Client, nat *api.Nat, opts ...EnsureOption) (*api.Nat, error) {
+	o := newEnsureOptions(opts)
+	e := &ensurer[*api.Nat]{
+		existsCodes: []uint32{errors.ALREADY_EXISTS, errors.SNAT_EXISTS},
+		create: func() (*api.Nat, error) {
+			return c.CreateNat(ctx, nat, o.callOptions...)
+		},
+		get: func() (*api.Nat, bool, error) {
+			cur, err := c.GetNat(ctx, nat.InterfaceID, o.callOptions...)
+			if errors.IsStatusErrorCode(err, errors.SNAT_NO_DATA, errors.NOT_FOUND) {
+				return nil, false, nil
+			}
+			return cur, err == nil, err
+		},
+		compare: func(cur *api.Nat, d *differ) {
+			d.addr("spec.nat_ip", cur.Spec.NatIP, nat.Spec.NatIP)
+			d.uint32("spec.min_port", cur.Spec.MinPort, nat.Spec.MinPort)
+			d.uint32("spec.max_port", cur.Spec.MaxPort, nat.Spec.MaxPort)
+		},
+		delete: func() error {
+			_, err := c.DeleteNat(ctx, nat.InterfaceID, o.callOptions...)
+			return err
+		},
+	}
+	return e.ensure(o)
+}
+
+// EnsureNeighborNat creates nat or returns the existing neighbor NAT with the same
+// NAT IP, VNI and port range if the underlay route matches.
+func EnsureNeighborNat(ctx context.Context, c Client, nat *api.NeighborNat, opts ...EnsureOption) (*api.NeighborNat, error) {
+	o := newEnsureOptions(opts)
+	e := &ensurer[*api.NeighborNat]{
+		existsCodes: []uint32{errors.ALREADY_EXISTS},
+		create: func() (*api.NeighborNat, error) {
+			return c.CreateNeighborNat(ctx, nat, o.callOptions...)
+		},
+		get: func() (*api.NeighborNat, bool, error) {
+			list, err := c.ListNeighborNats(ctx, nat.NatIP, o.callOptions...)
+			if err != nil {
+				return nil, false, err
+			}
+			for _, item := range list.Items {
+				if item.Spec.Vni == nat.Spec.Vni && item.Spec.MinPort == nat.Spec.MinPort && item.Spec.MaxPort == nat.Spec.MaxPort {
+					return &api.NeighborNat{
+						TypeMeta:        api.TypeMeta{Kind: api.NeighborNatKind},
+						NeighborNatMeta: api.NeighborNatMeta{NatIP: nat.NatIP},
+						Spec: api.NeighborNatSpec{
+							Vni:           item.Spec.Vni,
+							MinPort:       item.Spec.MinPort,
+							MaxPort:       item.Spec.MaxPort,
+							UnderlayRoute: item.Spec.UnderlayRoute,
+						},
+					}, true, nil
+				}
+			}
+			return nil, false, nil
+		},
+		compare: func(cur *api.NeighborNat, d *differ) {
+			d.addr("spec.underlay_route", cur.Spec.UnderlayRoute, nat.Spec.UnderlayRoute)
+		},
+		delete: func() error {
+			_, err := c.DeleteNeighborNat(ctx, nat, o.callOptions...)
+			return err
+		},
+	}
+	return e.ensure(o)
+}
+
+// EnsureRoute creates route or returns the existing route for the prefix if the next hop matches.
+func EnsureRoute(ctx context.Context, c Client, route *api.Route, opts ...EnsureOption) (*api.Route, error) {
+	o := newEnsureOptions(opts)
+	e := &ensurer[*api.Route]{
+		existsCodes: []uint32{errors.ALREADY_EXISTS, errors.ROUTE_EXISTS},
+		create: func() (*api.Route, error) {
+			return c.CreateRoute(ctx, route, o.callOptions...)
+		},
+		get: func() (*api.Route, bool, error) {
+			list, err := c.ListRoutes(ctx, route.VNI, o.callOptions...)
+			if err != nil {
+				return nil, false, err
+			}
+			for i := range list.Items {
+				if list.Items[i].Spec.Prefix != nil && list.Items[i].Spec.Prefix.Masked() == route.Spec.Prefix.Masked() {
+					return &list.Items[i], true, nil
+				}
+			}
+			return nil, false, nil
+		},
+		compare: func(cur *api.Route, d *differ) {
+			var curHop api.RouteNextHop
+			if cur.Spec.NextHop != nil {
+				curHop = *cur.Spec.NextHop
+			}
+			d.uint32("spec.next_hop.vni", curHop.VNI, route.Spec.NextHop.VNI)
+			d.addr("spec.next_hop.address", curHop.IP, route.Spec.NextHop.IP)
+		},
+		delete: func() error {
+			_, err := c.DeleteRoute(ctx, route.VNI, route.Spec.Prefix, o.callOptions...)
+			return err
+		},
+	}
+	return e.ensure(o)
+}
+
+// EnsureLoadBalancer creates lb or returns the existing load balancer with the same ID
+// if its VNI, IP and ports match.
+func EnsureLoadBalancer(ctx context.Context, c Client, lb *api.LoadBalancer, opts ...EnsureOption) (*api.LoadBalancer, error) {
+	o := newEnsureOptions(opts)
+	e := &ensurer[*api.LoadBalancer]{
+		existsCodes: []uint32{errors.ALREADY_EXISTS},
+		create: func() (*api.LoadBalancer, error) {
+			return c.CreateLoadBalancer(ctx, lb, o.callOptions...)
+		},
+		get: func() (*api.LoadBalancer, bool, error) {
+			cur, err := c.GetLoadBalancer(ctx, lb.ID, o.callOptions...)
+			if errors.IsStatusErrorCode(err, errors.NOT_FOUND, errors.NO_LB) {
+				return nil, false, nil
+			}
+			return cur, err == nil, err
+		},
+		compare: func(cur *api.LoadBalancer, d *differ) {
+			d.uint32("spec.vni", cur.Spec.VNI, lb.Spec.VNI)
+			d.addr("spec.loadbalanced_ip", cur.Spec.LbVipIP, lb.Spec.LbVipIP)
+			if existing, desired := formatLbports(cur.Spec.Lbports), formatLbports(lb.Spec.Lbports); existing != desired {
+				d.add("spec.loadbalanced_ports", existing, desired)
+			}
+		},
+		delete: func() error {
+			_, err := c.DeleteLoadBalancer(ctx, lb.ID, o.callOptions...)
+			return err
+		},
+	}
+	return e.ensure(o)
+}
+
+// EnsureLoadBalancerTarget creates target or returns the existing one.
+func EnsureLoadBalancerTarget(ctx context.Context, c Client, target *api.LoadBalancerTarget, opts ...EnsureOption) (*api.LoadBalancerTarget, error) {
+	o := newEnsureOptions(opts)
+	e := &ensurer[*api.LoadBalancerTarget]{
+		existsCodes: []uint32{errors.ALREADY_EXISTS},
+		create: func() (*api.LoadBalancerTarget, error) {
+			return c.CreateLoadBalancerTarget(ctx, target, o.callOptions...)
+		},
+		get: func() (*api.LoadBalancerTarget, bool, error) {
+			list, err := c.ListLoadBalancerTargets(ctx, target.LoadbalancerID, o.callOptions...)
+			if err != nil {
+				return nil, false, err
+			}
+			for i := range list.Items {
+				if list.Items[i].Spec.TargetIP != nil && target.Spec.TargetIP != nil && *list.Items[i].Spec.TargetIP == *target.Spec.TargetIP {
+					return &list.Items[i], true, nil
+				}
+			}
+			return nil, false, nil
+		},
+	}
+	return e.ensure(o)
+}
+
+// EnsureFirewallRule creates rule or returns the existing rule with the same ID
+// if its direction, action, priority, prefixes and protocol filter match.
+func EnsureFirewallRule(ctx context.Context, c Client, rule *api.FirewallRule, opts ...EnsureOption) (*api.FirewallRule, error) {
+	o := newEnsureOptions(opts)
+	e := &ensurer[*api.FirewallRule]{
+		existsCodes: []uint32{errors.ALREADY_EXISTS},
+		create: func() (*api.FirewallRule, error) {
+			return c.CreateFirewallRule(ctx, rule, o.callOptions...)
+		},
+		get: func() (*api.FirewallRule, bool, error) {
+			cur, err := c.GetFirewallRule(ctx, rule.InterfaceID, rule.Spec.RuleID, o.callOptions...)
+			if errors.IsStatusErrorCode(err, errors.NOT_FOUND) {
+				return nil, false, nil
+			}
+			return cur, err == nil, err
+		},
+		compare: func(cur *api.FirewallRule, d *differ) {
+			// CreateFirewallRule normalized direction and action of rule already.
+			d.string("spec.direction", cur.Spec.TrafficDirection, rule.Spec.TrafficDirection)
+			d.string("spec.action", cur.Spec.FirewallAction, rule.Spec.FirewallAction)
+			d.uint32("spec.priority", cur.Spec.Priority, rule.Spec.Priority)
+			d.prefix("spec.source_prefix", cur.Spec.SourcePrefix, rule.Spec.SourcePrefix)
+			d.prefix("spec.destination_prefix", cur.Spec.DestinationPrefix, rule.Spec.DestinationPrefix)
+
+			curFilter, wantFilter := cur.Spec.ProtocolFilter, rule.Spec.ProtocolFilter
+			if curFilter == nil {
+				curFilter = &dpdkproto.ProtocolFilter{}
+			}
+			if wantFilter == nil {
+				wantFilter = &dpdkproto.ProtocolFilter{}
+			}
+			if !proto.Equal(curFilter, wantFilter) {
+				d.add("spec.protocol_filter", "{"+curFilter.String()+"}", "{"+wantFilter.String()+"}")
+			}
+		},
+		delete: func() error {
+			_, err := c.DeleteFirewallRule(ctx, rule.InterfaceID, rule.Spec.RuleID, o.callOptions...)
+			return err
+		},
+	}
+	return e.ensure(o)
+}
@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"net/netip"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/errors"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ensure", Label("ensure"), func() {
+	var c Client
+
+	BeforeEach(func(ctx SpecContext) {
+		c = newFakeClient(ctx)
+	})
+
+	newInterface := func(vni uint32) *api.Interface {
+		ipv4 := netip.MustParseAddr("10.200.1.4")
+		ipv6 := netip.MustParseAddr("2000:200:1::4")
+		return &api.Interface{
+			InterfaceMeta: api.InterfaceMeta{ID: "vm4"},
+			Spec: api.InterfaceSpec{
+				VNI:    vni,
+				Device: "net_tap5",
+				IPv4:   &ipv4,
+				IPv6:   &ipv6,
+			},
+		}
+	}
+
+	It("should create a missing interface and return the existing one if it matches", func(ctx SpecContext) {
+		created, err := EnsureInterface(ctx, c, newInterface(200))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(created.Spec.UnderlayRoute).NotTo(BeNil())
+
+		existing, err := EnsureInterface(ctx, c, newInterface(200))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(existing.Spec.VNI).To(Equal(uint32(200)))
+		Expect(existing.Spec.UnderlayRoute).To(Equal(created.Spec.UnderlayRoute))
+	})
+
+	It("should return a conflict error or recreate a different interface", func(ctx SpecContext) {
+		_, err := EnsureInterface(ctx, c, newInterface(200))
+		Expect(err).NotTo(HaveOccurred())
+
+		existing, err := EnsureInterface(ctx, c, newInterface(300))
+		Expect(err).To(MatchError("Interface vm4 already exists with a different spec: spec.vni is 200, desired 300"))
+		Expect(err).To(BeAssignableToTypeOf(&ConflictError{}))
+		Expect(existing.Spec.VNI).To(Equal(uint32(200)))
+
+		recreated, err := EnsureInterface(ctx, c, newInterface(300), WithRecreate())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recreated.Spec.VNI).To(Equal(uint32(300)))
+
+		live, err := c.GetInterface(ctx, "vm4")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(live.Spec.VNI).To(Equal(uint32(300)))
+	})
+
+	It("should compare NATs, routes and load balancers", func(ctx SpecContext) {
+		_, err := EnsureInterface(ctx, c, newInterface(200))
+		Expect(err).NotTo(HaveOccurred())
+
+		natIP := netip.MustParseAddr("10.20.30.40")
+		nat := &api.Nat{
+			NatMeta: api.NatMeta{InterfaceID: "vm4"},
+			Spec:    api.NatSpec{NatIP: &natIP, MinPort: 100, MaxPort: 200},
+		}
+		_, err = EnsureNat(ctx, c, nat)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = EnsureNat(ctx, c, nat)
+		Expect(err).NotTo(HaveOccurred())
+		nat.Spec.MinPort, nat.Spec.MaxPort = 200, 400
+		_, err = EnsureNat(ctx, c, nat)
+		Expect(err).To(MatchError("Nat vm4 already exists with a different spec: spec.min_port is 100, desired 200, spec.max_port is 200, desired 400"))
+
+		prefix := netip.MustParsePrefix("10.100.3.0/24")
+		nextHop := netip.MustParseAddr("fc00:2::64:0:1")
+		route := &api.Route{
+			RouteMeta: api.RouteMeta{VNI: 200},
+			Spec: api.RouteSpec{
+				Prefix:  &prefix,
+				NextHop: &api.RouteNextHop{VNI: 0, IP: &nextHop},
+			},
+		}
+		_, err = EnsureRoute(ctx, c, route)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = EnsureRoute(ctx, c, route)
+		Expect(err).NotTo(HaveOccurred())
+		otherHop := netip.MustParseAddr("fc00:2::64:0:2")
+		route.Spec.NextHop.IP = &otherHop
+		_, err = EnsureRoute(ctx, c, route)
+		Expect(err).To(MatchError(ContainSubstring("spec.next_hop.address is fc00:2::64:0:1, desired fc00:2::64:0:2")))
+
+		lbIP := netip.MustParseAddr("10.20.30.50")
+		lb := &api.LoadBalancer{
+			LoadBalancerMeta: api.LoadBalancerMeta{ID: "lb4"},
+			Spec: api.LoadBalancerSpec{
+				VNI:     200,
+				LbVipIP: &lbIP,
+				Lbports: []api.LBPort{{Protocol: 6, Port: 443}, {Protocol: 17, Port: 53}},
+			},
+		}
+		_, err = EnsureLoadBalancer(ctx, c, lb)
+		Expect(err).NotTo(HaveOccurred())
+		lb.Spec.Lbports = []api.LBPort{{Protocol: 17, Port: 53}, {Protocol: 6, Port: 443}}
+		_, err = EnsureLoadBalancer(ctx, c, lb)
+		Expect(err).NotTo(HaveOccurred())
+		lb.Spec.Lbports = []api.LBPort{{Protocol: 6, Port: 80}}
+		_, err = EnsureLoadBalancer(ctx, c, lb)
+		Expect(err).To(MatchError(ContainSubstring("spec.loadbalanced_ports is [tcp/443 udp/53], desired [tcp/80]")))
+	})
+
+	It("should return the existing firewall rule and prefix", func(ctx SpecContext) {
+		_, err := EnsureInterface(ctx, c, newInterface(200))
+		Expect(err).NotTo(HaveOccurred())
+
+		src := netip.MustParsePrefix("1.1.1.0/24")
+		dst := netip.MustParsePrefix("5.5.5.0/24")
+		rule := &api.FirewallRule{
+			FirewallRuleMeta: api.FirewallRuleMeta{InterfaceID: "vm4"},
+			Spec: api.FirewallRuleSpec{
+				RuleID:            "fr4",
+				TrafficDirection:  "ingress",
+				FirewallAction:    "accept",
+				Priority:          1000,
+				SourcePrefix:      &src,
+				DestinationPrefix: &dst,
+			},
+		}
+		_, err = EnsureFirewallRule(ctx, c, rule)
+		Expect(err).NotTo(HaveOccurred())
+		existing, err := EnsureFirewallRule(ctx, c, rule)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(existing.Spec.Priority).To(Equal(uint32(1000)))
+		rule.Spec.FirewallAction = "drop"
+		_, err = EnsureFirewallRule(ctx, c, rule)
+		Expect(err).To(MatchError(ContainSubstring(`spec.action is "Accept", desired "Drop"`)))
+
+		prefix := &api.Prefix{
+			PrefixMeta: api.PrefixMeta{InterfaceID: "vm4"},
+			Spec:       api.PrefixSpec{Prefix: netip.MustParsePrefix("10.20.0.0/24")},
+		}
+		created, err := EnsurePrefix(ctx, c, prefix)
+		Expect(err).NotTo(HaveOccurred())
+		existingPrefix, err := EnsurePrefix(ctx, c, prefix)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(existingPrefix.Spec.UnderlayRoute).To(Equal(created.Spec.UnderlayRoute))
+	})
+
+	It("should return other errors unchanged", func(ctx SpecContext) {
+		_, err := EnsureVirtualIP(ctx, c, &api.VirtualIP{
+			VirtualIPMeta: api.VirtualIPMeta{InterfaceID: "does-not-exist"},
+			Spec:          api.VirtualIPSpec{IP: ptrAddr("20.20.20.20")},
+		})
+		Expect(errors.IsStatusErrorCode(err, errors.NO_VM, errors.NOT_FOUND)).To(BeTrue())
+	})
+})
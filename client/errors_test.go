@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+
+	"github.com/ironcore-dev/dpservice-go/dpservicetest"
+	"github.com/ironcore-dev/dpservice-go/errors"
+	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("status errors", Label("errors"), func() {
+	ctx := context.TODO()
+
+	It("should match sentinel errors with errors.Is", func() {
+		_, err := dpdkClient.GetInterface(ctx, "does-not-exist")
+		Expect(err).To(HaveOccurred())
+		Expect(stderrors.Is(err, errors.ErrNotFound)).To(BeTrue())
+		Expect(stderrors.Is(fmt.Errorf("error getting interface: %w", err), errors.ErrNotFound)).To(BeTrue())
+		Expect(stderrors.Is(err, errors.ErrAlreadyExists)).To(BeFalse())
+		Expect(errors.ErrNotFound.Message()).To(Equal("NOT_FOUND"))
+	})
+
+	DescribeTable("naming and categorizing status codes",
+		func(code uint32, name string, category errors.Category) {
+			Expect(errors.CodeName(code)).To(Equal(name))
+			Expect(errors.NewStatusError(code, "").Category()).To(Equal(category))
+		},
+		Entry(nil, uint32(errors.BAD_REQUEST), "BAD_REQUEST", errors.CategoryRequest),
+		Entry(nil, uint32(errors.NO_VNI), "NO_VNI", errors.CategoryGeneral),
+		Entry(nil, uint32(errors.ROUTE_EXISTS), "ROUTE_EXISTS", errors.CategoryRoute),
+		Entry(nil, uint32(errors.DNAT_EXISTS), "DNAT_EXISTS", errors.CategoryNAT),
+		Entry(nil, uint32(errors.SNAT_NO_DATA), "SNAT_NO_DATA", errors.CategoryNAT),
+		Entry(nil, uint32(errors.VNI_FREE6), "VNI_FREE6", errors.CategoryVNI),
+		Entry(nil, uint32(errors.PORT_START), "PORT_START", errors.CategoryPort),
+		Entry(nil, uint32(errors.NO_LB), "NO_LB", errors.CategoryLoadBalancer),
+		Entry(nil, uint32(999), "UNKNOWN(999)", errors.CategoryUnknown),
+	)
+
+	It("should survive a hop through a gRPC service", func(ctx SpecContext) {
+		// The proxy returns the StatusError it got from dp-service as gRPC error.
+		socket := filepath.Join(GinkgoT().TempDir(), "proxy.sock")
+		lis, err := net.Listen("unix", socket)
+		Expect(err).NotTo(HaveOccurred())
+		srv := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			return nil, errors.NewStatusError(errors.SNAT_EXISTS, "SNAT_EXISTS")
+		}))
+		dpdkproto.RegisterDPDKironcoreServer(srv, dpservicetest.NewServer())
+		go func() {
+			_ = srv.Serve(lis)
+		}()
+		DeferCleanup(srv.Stop)
+
+		conn, err := grpc.DialContext(ctx, "unix://"+socket, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(conn.Close)
+
+		_, err = dpdkproto.NewDPDKironcoreClient(conn).CheckInitialized(ctx, &dpdkproto.CheckInitializedRequest{})
+		Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+		Expect(stderrors.Is(err, errors.ErrSNATExists)).To(BeFalse())
+
+		err = errors.FromGRPCError(err)
+		Expect(stderrors.Is(err, errors.ErrSNATExists)).To(BeTrue())
+		Expect(err.Error()).To(Equal("[error code 343] SNAT_EXISTS"))
+	})
+
+	DescribeTable("mapping status codes to gRPC and HTTP",
+		func(code uint32, grpcCode codes.Code, httpStatus int) {
+			statusErr := errors.NewStatusError(code, errors.CodeName(code))
+			Expect(statusErr.GRPCStatus().Code()).To(Equal(grpcCode))
+			Expect(statusErr.HTTPStatus()).To(Equal(httpStatus))
+
+			restored, ok := errors.FromGRPCStatus(statusErr.GRPCStatus())
+			Expect(ok).To(BeTrue())
+			Expect(restored).To(Equal(statusErr))
+		},
+		Entry(nil, uint32(errors.BAD_REQUEST), codes.InvalidArgument, http.StatusBadRequest),
+		Entry(nil, uint32(errors.NOT_FOUND), codes.NotFound, http.StatusNotFound),
+		Entry(nil, uint32(errors.ALREADY_EXISTS), codes.AlreadyExists, http.StatusConflict),
+		Entry(nil, uint32(errors.LIMIT_REACHED), codes.ResourceExhausted, http.StatusTooManyRequests),
+		Entry(nil, uint32(errors.ROLLBACK), codes.Unavailable, http.StatusServiceUnavailable),
+		Entry(nil, uint32(errors.VNI_INIT4), codes.Internal, http.StatusInternalServerError),
+		Entry(nil, uint32(999), codes.Unknown, http.StatusInternalServerError),
+	)
+})
@@ -0,0 +1,254 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+)
+
+// LegacyClient has the signatures of Client before call options were introduced,
+// taking status codes to ignore, e.g. created with errors.Ignore, instead of CallOptions.
+//
+// Deprecated: Use Client with IgnoreErrors instead.
+type LegacyClient interface {
+	GetLoadBalancer(ctx context.Context, id string, ignoredErrors ...[]uint32) (*api.LoadBalancer, error)
+	CreateLoadBalancer(ctx context.Context, lb *api.LoadBalancer, ignoredErrors ...[]uint32) (*api.LoadBalancer, error)
+	DeleteLoadBalancer(ctx context.Context, id string, ignoredErrors ...[]uint32) (*api.LoadBalancer, error)
+	ListLoadBalancerPrefixes(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.PrefixList, error)
+	CreateLoadBalancerPrefix(ctx context.Context, prefix *api.LoadBalancerPrefix, ignoredErrors ...[]uint32) (*api.LoadBalancerPrefix, error)
+	DeleteLoadBalancerPrefix(ctx context.Context, interfaceID string, prefix *netip.Prefix, ignoredErrors ...[]uint32) (*api.LoadBalancerPrefix, error)
+	ListLoadBalancerTargets(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.LoadBalancerTargetList, error)
+	CreateLoadBalancerTarget(ctx context.Context, lbtarget *api.LoadBalancerTarget, ignoredErrors ...[]uint32) (*api.LoadBalancerTarget, error)
+	DeleteLoadBalancerTarget(ctx context.Context, id string, targetIP *netip.Addr, ignoredErrors ...[]uint32) (*api.LoadBalancerTarget, error)
+	GetInterface(ctx context.Context, id string, ignoredErrors ...[]uint32) (*api.Interface, error)
+	ListInterfaces(ctx context.Context, ignoredErrors ...[]uint32) (*api.InterfaceList, error)
+	CreateInterface(ctx context.Context, iface *api.Interface, ignoredErrors ...[]uint32) (*api.Interface, error)
+	DeleteInterface(ctx context.Context, id string, ignoredErrors ...[]uint32) (*api.Interface, error)
+	GetVirtualIP(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.VirtualIP, error)
+	CreateVirtualIP(ctx context.Context, virtualIP *api.VirtualIP, ignoredErrors ...[]uint32) (*api.VirtualIP, error)
+	DeleteVirtualIP(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.VirtualIP, error)
+	ListPrefixes(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.PrefixList, error)
+	CreatePrefix(ctx context.Context, prefix *api.Prefix, ignoredErrors ...[]uint32) (*api.Prefix, error)
+	DeletePrefix(ctx context.Context, interfaceID string, prefix *netip.Prefix, ignoredErrors ...[]uint32) (*api.Prefix, error)
+	ListRoutes(ctx context.Context, vni uint32, ignoredErrors ...[]uint32) (*api.RouteList, error)
+	CreateRoute(ctx context.Context, route *api.Route, ignoredErrors ...[]uint32) (*api.Route, error)
+	DeleteRoute(ctx context.Context, vni uint32, prefix *netip.Prefix, ignoredErrors ...[]uint32) (*api.Route, error)
+	GetNat(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.Nat, error)
+	CreateNat(ctx context.Context, nat *api.Nat, ignoredErrors ...[]uint32) (*api.Nat, error)
+	DeleteNat(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.Nat, error)
+	ListLocalNats(ctx context.Context, natIP *netip.Addr, ignoredErrors ...[]uint32) (*api.NatList, error)
+	CreateNeighborNat(ctx context.Context, nat *api.NeighborNat, ignoredErrors ...[]uint32) (*api.NeighborNat, error)
+	ListNats(ctx context.Context, natIP *netip.Addr, natType string, ignoredErrors ...[]uint32) (*api.NatList, error)
+	DeleteNeighborNat(ctx context.Context, neigbhorNat *api.NeighborNat, ignoredErrors ...[]uint32) (*api.NeighborNat, error)
+	ListNeighborNats(ctx context.Context, natIP *netip.Addr, ignoredErrors ...[]uint32) (*api.NatList, error)
+	ListFirewallRules(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.FirewallRuleList, error)
+	CreateFirewallRule(ctx context.Context, fwRule *api.FirewallRule, ignoredErrors ...[]uint32) (*api.FirewallRule, error)
+	GetFirewallRule(ctx context.Context, interfaceID string, ruleID string, ignoredErrors ...[]uint32) (*api.FirewallRule, error)
+	DeleteFirewallRule(ctx context.Context, interfaceID string, ruleID string, ignoredErrors ...[]uint32) (*api.FirewallRule, error)
+	CheckInitialized(ctx context.Context, ignoredErrors ...[]uint32) (*api.Initialized, error)
+	Initialize(ctx context.Context, ignoredErrors ...[]uint32) (*api.Initialized, error)
+	GetVni(ctx context.Context, vni uint32, vniType uint8, ignoredErrors ...[]uint32) (*api.Vni, error)
+	ResetVni(ctx context.Context, vni uint32, vniType uint8, ignoredErrors ...[]uint32) (*api.Vni, error)
+	GetVersion(ctx context.Context, version *api.Version, ignoredErrors ...[]uint32) (*api.Version, error)
+	CaptureStart(ctx context.Context, capture *api.CaptureStart, ignoredErrors ...[]uint32) (*api.CaptureStart, error)
+	CaptureStop(ctx context.Context, ignoredErrors ...[]uint32) (*api.CaptureStop, error)
+	CaptureStatus(ctx context.Context, ignoredErrors ...[]uint32) (*api.CaptureStatus, error)
+
+	Close() error
+}
+
+// NewLegacyClient adapts c to the LegacyClient signatures. Unlike before, all
+// passed ignore lists are combined instead of only the first one being used.
+//
+// Deprecated: Use Client with IgnoreErrors instead.
+func NewLegacyClient(c Client) LegacyClient {
+	return &legacyClient{c: c}
+}
+
+type legacyClient struct {
+	c Client
+}
+
+func ignore(ignoredErrors [][]uint32) CallOption {
+	return func(o *callOptions) {
+		for _, codes := range ignoredErrors {
+			o.ignoredErrors = append(o.ignoredErrors, codes...)
+		}
+	}
+}
+
+func (l *legacyClient) GetLoadBalancer(ctx context.Context, id string, ignoredErrors ...[]uint32) (*api.LoadBalancer, error) {
+	return l.c.GetLoadBalancer(ctx, id, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) CreateLoadBalancer(ctx context.Context, lb *api.LoadBalancer, ignoredErrors ...[]uint32) (*api.LoadBalancer, error) {
+	return l.c.CreateLoadBalancer(ctx, lb, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) DeleteLoadBalancer(ctx context.Context, id string, ignoredErrors ...[]uint32) (*api.LoadBalancer, error) {
+	return l.c.DeleteLoadBalancer(ctx, id, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) ListLoadBalancerPrefixes(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.PrefixList, error) {
+	return l.c.ListLoadBalancerPrefixes(ctx, interfaceID, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) CreateLoadBalancerPrefix(ctx context.Context, prefix *api.LoadBalancerPrefix, ignoredErrors ...[]uint32) (*api.LoadBalancerPrefix, error) {
+	return l.c.CreateLoadBalancerPrefix(ctx, prefix, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) DeleteLoadBalancerPrefix(ctx context.Context, interfaceID string, prefix *netip.Prefix, ignoredErrors ...[]uint32) (*api.LoadBalancerPrefix, error) {
+	return l.c.DeleteLoadBalancerPrefix(ctx, interfaceID, prefix, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) ListLoadBalancerTargets(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.LoadBalancerTargetList, error) {
+	return l.c.ListLoadBalancerTargets(ctx, interfaceID, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) CreateLoadBalancerTarget(ctx context.Context, lbtarget *api.LoadBalancerTarget, ignoredErrors ...[]uint32) (*api.LoadBalancerTarget, error) {
+	return l.c.CreateLoadBalancerTarget(ctx, lbtarget, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) DeleteLoadBalancerTarget(ctx context.Context, id string, targetIP *netip.Addr, ignoredErrors ...[]uint32) (*api.LoadBalancerTarget, error) {
+	return l.c.DeleteLoadBalancerTarget(ctx, id, targetIP, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) GetInterface(ctx context.Context, id string, ignoredErrors ...[]uint32) (*api.Interface, error) {
+	return l.c.GetInterface(ctx, id, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) ListInterfaces(ctx context.Context, ignoredErrors ...[]uint32) (*api.InterfaceList, error) {
+	return l.c.ListInterfaces(ctx, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) CreateInterface(ctx context.Context, iface *api.Interface, ignoredErrors ...[]uint32) (*api.Interface, error) {
+	return l.c.CreateInterface(ctx, iface, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) DeleteInterface(ctx context.Context, id string, ignoredErrors ...[]uint32) (*api.Interface, error) {
+	return l.c.DeleteInterface(ctx, id, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) GetVirtualIP(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.VirtualIP, error) {
+	return l.c.GetVirtualIP(ctx, interfaceID, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) CreateVirtualIP(ctx context.Context, virtualIP *api.VirtualIP, ignoredErrors ...[]uint32) (*api.VirtualIP, error) {
+	return l.c.CreateVirtualIP(ctx, virtualIP, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) DeleteVirtualIP(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.VirtualIP, error) {
+	return l.c.DeleteVirtualIP(ctx, interfaceID, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) ListPrefixes(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.PrefixList, error) {
+	return l.c.ListPrefixes(ctx, interfaceID, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) CreatePrefix(ctx context.Context, prefix *api.Prefix, ignoredErrors ...[]uint32) (*api.Prefix, error) {
+	return l.c.CreatePrefix(ctx, prefix, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) DeletePrefix(ctx context.Context, interfaceID string, prefix *netip.Prefix, ignoredErrors ...[]uint32) (*api.Prefix, error) {
+	return l.c.DeletePrefix(ctx, interfaceID, prefix, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) ListRoutes(ctx context.Context, vni uint32, ignoredErrors ...[]uint32) (*api.RouteList, error) {
+	return l.c.ListRoutes(ctx, vni, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) CreateRoute(ctx context.Context, route *api.Route, ignoredErrors ...[]uint32) (*api.Route, error) {
+	return l.c.CreateRoute(ctx, route, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) DeleteRoute(ctx context.Context, vni uint32, prefix *netip.Prefix, ignoredErrors ...[]uint32) (*api.Route, error) {
+	return l.c.DeleteRoute(ctx, vni, prefix, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) GetNat(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.Nat, error) {
+	return l.c.GetNat(ctx, interfaceID, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) CreateNat(ctx context.Context, nat *api.Nat, ignoredErrors ...[]uint32) (*api.Nat, error) {
+	return l.c.CreateNat(ctx, nat, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) DeleteNat(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.Nat, error) {
+	return l.c.DeleteNat(ctx, interfaceID, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) ListLocalNats(ctx context.Context, natIP *netip.Addr, ignoredErrors ...[]uint32) (*api.NatList, error) {
+	return l.c.ListLocalNats(ctx, natIP, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) CreateNeighborNat(ctx context.Context, nat *api.NeighborNat, ignoredErrors ...[]uint32) (*api.NeighborNat, error) {
+	return l.c.CreateNeighborNat(ctx, nat, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) ListNats(ctx context.Context, natIP *netip.Addr, natType string, ignoredErrors ...[]uint32) (*api.NatList, error) {
+	return l.c.ListNats(ctx, natIP, natType, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) DeleteNeighborNat(ctx context.Context, neigbhorNat *api.NeighborNat, ignoredErrors ...[]uint32) (*api.NeighborNat, error) {
+	return l.c.DeleteNeighborNat(ctx, neigbhorNat, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) ListNeighborNats(ctx context.Context, natIP *netip.Addr, ignoredErrors ...[]uint32) (*api.NatList, error) {
+	return l.c.ListNeighborNats(ctx, natIP, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) ListFirewallRules(ctx context.Context, interfaceID string, ignoredErrors ...[]uint32) (*api.FirewallRuleList, error) {
+	return l.c.ListFirewallRules(ctx, interfaceID, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) CreateFirewallRule(ctx context.Context, fwRule *api.FirewallRule, ignoredErrors ...[]uint32) (*api.FirewallRule, error) {
+	return l.c.CreateFirewallRule(ctx, fwRule, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) GetFirewallRule(ctx context.Context, interfaceID string, ruleID string, ignoredErrors ...[]uint32) (*api.FirewallRule, error) {
+	return l.c.GetFirewallRule(ctx, interfaceID, ruleID, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) DeleteFirewallRule(ctx context.Context, interfaceID string, ruleID string, ignoredErrors ...[]uint32) (*api.FirewallRule, error) {
+	return l.c.DeleteFirewallRule(ctx, interfaceID, ruleID, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) CheckInitialized(ctx context.Context, ignoredErrors ...[]uint32) (*api.Initialized, error) {
+	return l.c.CheckInitialized(ctx, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) Initialize(ctx context.Context, ignoredErrors ...[]uint32) (*api.Initialized, error) {
+	return l.c.Initialize(ctx, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) GetVni(ctx context.Context, vni uint32, vniType uint8, ignoredErrors ...[]uint32) (*api.Vni, error) {
+	return l.c.GetVni(ctx, vni, vniType, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) ResetVni(ctx context.Context, vni uint32, vniType uint8, ignoredErrors ...[]uint32) (*api.Vni, error) {
+	return l.c.ResetVni(ctx, vni, vniType, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) GetVersion(ctx context.Context, version *api.Version, ignoredErrors ...[]uint32) (*api.Version, error) {
+	return l.c.GetVersion(ctx, version, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) CaptureStart(ctx context.Context, capture *api.CaptureStart, ignoredErrors ...[]uint32) (*api.CaptureStart, error) {
+	return l.c.CaptureStart(ctx, capture, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) CaptureStop(ctx context.Context, ignoredErrors ...[]uint32) (*api.CaptureStop, error) {
+	return l.c.CaptureStop(ctx, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) CaptureStatus(ctx context.Context, ignoredErrors ...[]uint32) (*api.CaptureStatus, error) {
+	return l.c.CaptureStatus(ctx, ignore(ignoredErrors))
+}
+
+func (l *legacyClient) Close() error {
+	return l.c.Close()
+}
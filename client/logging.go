@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	stderrors "errors"
+	"net/netip"
+
+	"github.com/go-logr/logr"
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/errors"
+)
+
+// loggingClient logs the calls of a Client, see WithLogger.
+type loggingClient struct {
+	Client
+	log          logr.Logger
+	callLevel    int
+	ignoredLevel int
+}
+
+// logMutation logs a mutating call with the status returned by dp-service.
+func logMutation[T any](c *loggingClient, method string, values []any, res T, err error) {
+	statusErr := &errors.StatusError{}
+	switch {
+	case stderrors.As(err, &statusErr):
+		c.info(c.callLevel, "dp-service returned an error status", method, values,
+			"code", statusErr.ErrorCode(), "status", errors.CodeName(statusErr.ErrorCode()), "message", statusErr.Message())
+	case err != nil:
+		c.info(c.callLevel, "Calling dp-service failed", method, values, "error", err.Error())
+	default:
+		status := resultStatus(res)
+		if status.Code != 0 {
+			c.logIgnored(method, values, status)
+			return
+		}
+		c.info(c.callLevel, "Called dp-service", method, values, "code", status.Code, "message", status.Message)
+	}
+}
+
+// logRead logs the status codes of a reading call that were ignored with IgnoreErrors.
+func logRead[T any](c *loggingClient, method string, values []any, res T, err error) {
+	if err != nil {
+		return
+	}
+	if status := resultStatus(res); status.Code != 0 {
+		c.logIgnored(method, values, status)
+	}
+}
+
+func (c *loggingClient) logIgnored(method string, values []any, status api.Status) {
+	c.info(c.ignoredLevel, "Ignored dp-service status", method, values,
+		"code", status.Code, "status", errors.CodeName(status.Code), "message", status.Message)
+}
+
+// logSkippedCaptures logs the interfaces CaptureStart skipped because of WithLenientCapture.
+func (c *loggingClient) logSkippedCaptures(res *api.CaptureStart) {
+	for _, iface := range res.Status.SkippedInterfaces {
+		c.log.Info("Skipped invalid captured interface", "method", "CaptureStart",
+			"interface", iface.InterfaceInfo, "type", iface.InterfaceType)
+	}
+}
+
+func (c *loggingClient) info(level int, msg, method string, values []any, status ...any) {
+	c.log.V(level).Info(msg, append(append([]any{"method", method}, values...), status...)...)
+}
+
+func resultStatus[T any](res T) api.Status {
+	if obj, ok := any(res).(interface{ GetStatus() api.Status }); ok {
+		return obj.GetStatus()
+	}
+	return api.Status{}
+}
+
+func prefixLogValue(prefix *netip.Prefix) any {
+	if prefix == nil {
+		return nil
+	}
+	return prefix.String()
+}
+
+func addrLogValue(addr *netip.Addr) any {
+	if addr == nil {
+		return nil
+	}
+	return addr.String()
+}
+
+// objectLogValues returns the key value pairs summarizing a request object.
+func objectLogValues(obj api.Object) []any {
+	switch obj := obj.(type) {
+	case *api.Interface:
+		return []any{"interface", obj.ID, "vni", obj.Spec.VNI, "device", obj.Spec.Device,
+			"ipv4", addrLogValue(obj.Spec.IPv4), "ipv6", addrLogValue(obj.Spec.IPv6)}
+	case *api.LoadBalancer:
+		return []any{"loadbalancer", obj.ID, "vni", obj.Spec.VNI, "vip", addrLogValue(obj.Spec.LbVipIP)}
+	case *api.LoadBalancerPrefix:
+		return []any{"interface", obj.InterfaceID, "prefix", obj.Spec.Prefix.String()}
+	case *api.LoadBalancerTarget:
+		return []any{"loadbalancer", obj.LoadbalancerID, "target", addrLogValue(obj.Spec.TargetIP)}
+	case *api.Prefix:
+		return []any{"interface", obj.InterfaceID, "prefix", obj.Spec.Prefix.String()}
+	case *api.VirtualIP:
+		return []any{"interface", obj.InterfaceID, "vip", addrLogValue(obj.Spec.IP)}
+	case *api.Route:
+		var nextHop any
+		if obj.Spec.NextHop != nil {
+			nextHop = addrLogValue(obj.Spec.NextHop.IP)
+		}
+		return []any{"vni", obj.VNI, "prefix", prefixLogValue(obj.Spec.Prefix), "next_hop", nextHop}
+	case *api.Nat:
+		return []any{"interface", obj.InterfaceID, "nat_ip", addrLogValue(obj.Spec.NatIP),
+			"min_port", obj.Spec.MinPort, "max_port", obj.Spec.MaxPort}
+	case *api.NeighborNat:
+		return []any{"nat_ip", addrLogValue(obj.NatIP), "vni", obj.Spec.Vni,
+			"min_port", obj.Spec.MinPort, "max_port", obj.Spec.MaxPort}
+	case *api.FirewallRule:
+		return []any{"interface", obj.InterfaceID, "firewall_rule", obj.Spec.RuleID}
+	case *api.CaptureStart:
+		return []any{"interfaces", len(obj.Spec.Interfaces)}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,266 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+)
+
+func (c *loggingClient) GetLoadBalancer(ctx context.Context, id string, opts ...CallOption) (*api.LoadBalancer, error) {
+	res, err := c.Client.GetLoadBalancer(ctx, id, opts...)
+	logRead(c, "GetLoadBalancer", []any{"loadbalancer", id}, res, err)
+	return res, err
+}
+
+func (c *loggingClient) CreateLoadBalancer(ctx context.Context, lb *api.LoadBalancer, opts ...CallOption) (*api.LoadBalancer, error) {
+	res, err := c.Client.CreateLoadBalancer(ctx, lb, opts...)
+	logMutation(c, "CreateLoadBalancer", objectLogValues(lb), res, err)
+	return res, err
+}
+
+func (c *loggingClient) DeleteLoadBalancer(ctx context.Context, id string, opts ...CallOption) (*api.LoadBalancer, error) {
+	res, err := c.Client.DeleteLoadBalancer(ctx, id, opts...)
+	logMutation(c, "DeleteLoadBalancer", []any{"loadbalancer", id}, res, err)
+	return res, err
+}
+
+func (c *loggingClient) ListLoadBalancerPrefixes(ctx context.Context, interfaceID string, opts ...CallOption) (*api.PrefixList, error) {
+	res, err := c.Client.ListLoadBalancerPrefixes(ctx, interfaceID, opts...)
+	logRead(c, "ListLoadBalancerPrefixes", []any{"interface", interfaceID}, res, err)
+	return res, err
+}
+
+func (c *loggingClient) CreateLoadBalancerPrefix(ctx context.Context, prefix *api.LoadBalancerPrefix, opts ...CallOption) (*api.LoadBalancerPrefix, error) {
+	res, err := c.Client.CreateLoadBalancerPrefix(ctx, prefix, opts...)
+	logMutation(c, "CreateLoadBalancerPrefix", objectLogValues(prefix), res, err)
+	return res, err
+}
+
+func (c *loggingClient) DeleteLoadBalancerPrefix(ctx context.Context, interfaceID string, prefix *netip.Prefix, opts ...CallOption) (*api.LoadBalancerPrefix, error) {
+	res, err := c.Client.DeleteLoadBalancerPrefix(ctx, interfaceID, prefix, opts...)
+	logMutation(c, "DeleteLoadBalancerPrefix", []any{"interface", interfaceID, "prefix", prefixLogValue(prefix)}, res, err)
+	return res, err
+}
+
+func (c *loggingClient) ListLoadBalancerTargets(ctx context.Context, interfaceID string, opts ...CallOption) (*api.LoadBalancerTargetList, error) {
+	res, err := c.Client.ListLoadBalancerTargets(ctx, interfaceID, opts...)
+	logRead(c, "ListLoadBalancerTargets", []any{"loadbalancer", interfaceID}, res, err)
+	return res, err
+}
+
+func (c *loggingClient) CreateLoadBalancerTarget(ctx context.Context, lbtarget *api.LoadBalancerTarget, opts ...CallOption) (*api.LoadBalancerTarget, error) {
+	res, err := c.Client.CreateLoadBalancerTarget(ctx, lbtarget, opts...)
+	logMutation(c, "CreateLoadBalancerTarget", objectLogValues(lbtarget), res, err)
+	return res, err
+}
+
+func (c *loggingClient) DeleteLoadBalancerTarget(ctx context.Context, id string, targetIP *netip.Addr, opts ...CallOption) (*api.LoadBalancerTarget, error) {
+	res, err := c.Client.DeleteLoadBalancerTarget(ctx, id, targetIP, opts...)
+	logMutation(c, "DeleteLoadBalancerTarget", []any{"loadbalancer", id, "target", addrLogValue(targetIP)}, res, err)
+	return res, err
+}
+
+func (c *loggingClient) GetInterface(ctx context.Context, id string, opts ...CallOption) (*api.Interface, error) {
+	res, err := c.Client.GetInterface(ctx, id, opts...)
+	logRead(c, "GetInterface", []any{"interface", id}, res, err)
+	return res, err
+}
+
+func (c *loggingClient) ListInterfaces(ctx context.Context, opts ...CallOption) (*api.InterfaceList, error) {
+	res, err := c.Client.ListInterfaces(ctx, opts...)
+	logRead(c, "ListInterfaces", nil, res, err)
+	return res, err
+}
+
+func (c *loggingClient) CreateInterface(ctx context.Context, iface *api.Interface, opts ...CallOption) (*api.Interface, error) {
+	res, err := c.Client.CreateInterface(ctx, iface, opts...)
+	logMutation(c, "CreateInterface", objectLogValues(iface), res, err)
+	return res, err
+}
+
+func (c *loggingClient) DeleteInterface(ctx context.Context, id string, opts ...CallOption) (*api.Interface, error) {
+	res, err := c.Client.DeleteInterface(ctx, id, opts...)
+	logMutation(c, "DeleteInterface", []any{"interface", id}, res, err)
+	return res, err
+}
+
+func (c *loggingClient) GetVirtualIP(ctx context.Context, interfaceID string, opts ...CallOption) (*api.VirtualIP, error) {
+	res, err := c.Client.GetVirtualIP(ctx, interfaceID, opts...)
+	logRead(c, "GetVirtualIP", []any{"interface", interfaceID}, res, err)
+	return res, err
+}
+
+func (c *loggingClient) CreateVirtualIP(ctx context.Context, virtualIP *api.VirtualIP, opts ...CallOption) (*api.VirtualIP, error) {
+	res, err := c.Client.CreateVirtualIP(ctx, virtualIP, opts...)
+	logMutation(c, "CreateVirtualIP", objectLogValues(virtualIP), res, err)
+	return res, err
+}
+
+func (c *loggingClient) DeleteVirtualIP(ctx context.Context, interfaceID string, opts ...CallOption) (*api.VirtualIP, error) {
+	res, err := c.Client.DeleteVirtualIP(ctx, interfaceID, opts...)
+	logMutation(c, "DeleteVirtualIP", []any{"interface", interfaceID}, res, err)
+	return res, err
+}
+
+func (c *loggingClient) ListPrefixes(ctx context.Context, interfaceID string, opts ...CallOption) (*api.PrefixList, error) {
+	res, err := c.Client.ListPrefixes(ctx, interfaceID, opts...)
+	logRead(c, "ListPrefixes", []any{"interface", interfaceID}, res, err)
+	return res, err
+}
+
+func (c *loggingClient) CreatePrefix(ctx context.Context, prefix *api.Prefix, opts ...CallOption) (*api.Prefix, error) {
+	res, err := c.Client.CreatePrefix(ctx, prefix, opts...)
+	logMutation(c, "CreatePrefix", objectLogValues(prefix), res, err)
+	return res, err
+}
+
+func (c *loggingClient) DeletePrefix(ctx context.Context, interfaceID string, prefix *netip.Prefix, opts ...CallOption) (*api.Prefix, error) {
+	res, err := c.Client.DeletePrefix(ctx, interfaceID, prefix, opts...)
+	logMutation(c, "DeletePrefix", []any{"interface", interfaceID, "prefix", prefixLogValue(prefix)}, res, err)
+	return res, err
+}
+
+func (c *loggingClient) ListRoutes(ctx context.Context, vni uint32, opts ...CallOption) (*api.RouteList, error) {
+	res, err := c.Client.ListRoutes(ctx, vni, opts...)
+	logRead(c, "ListRoutes", []any{"vni", vni}, res, err)
+	return res, err
+}
+
+func (c *loggingClient) CreateRoute(ctx context.Context, route *api.Route, opts ...CallOption) (*api.Route, error) {
+	res, err := c.Client.CreateRoute(ctx, route, opts...)
+	logMutation(c, "CreateRoute", objectLogValues(route), res, err)
+	return res, err
+}
+
+func (c *loggingClient) DeleteRoute(ctx context.Context, vni uint32, prefix *netip.Prefix, opts ...CallOption) (*api.Route, error) {
+	res, err := c.Client.DeleteRoute(ctx, vni, prefix, opts...)
+	logMutation(c, "DeleteRoute", []any{"vni", vni, "prefix", prefixLogValue(prefix)}, res, err)
+	return res, err
+}
+
+func (c *loggingClient) GetNat(ctx context.Context, interfaceID string, opts ...CallOption) (*api.Nat, error) {
+	res, err := c.Client.GetNat(ctx, interfaceID, opts...)
+	logRead(c, "GetNat", []any{"interface", interfaceID}, res, err)
+	return res, err
+}
+
+func (c *loggingClient) CreateNat(ctx context.Context, nat *api.Nat, opts ...CallOption) (*api.Nat, error) {
+	res, err := c.Client.CreateNat(ctx, nat, opts...)
+	logMutation(c, "CreateNat", objectLogValues(nat), res, err)
+	return res, err
+}
+
+func (c *loggingClient) DeleteNat(ctx context.Context, interfaceID string, opts ...CallOption) (*api.Nat, error) {
+	res, err := c.Client.DeleteNat(ctx, interfaceID, opts...)
+	logMutation(c, "DeleteNat", []any{"interface", interfaceID}, res, err)
+	return res, err
+}
+
+func (c *loggingClient) ListLocalNats(ctx context.Context, natIP *netip.Addr, opts ...CallOption) (*api.NatList, error) {
+	res, err := c.Client.ListLocalNats(ctx, natIP, opts...)
+	logRead(c, "ListLocalNats", []any{"nat_ip", addrLogValue(natIP)}, res, err)
+	return res, err
+}
+
+func (c *loggingClient) CreateNeighborNat(ctx context.Context, nat *api.NeighborNat, opts ...CallOption) (*api.NeighborNat, error) {
+	res, err := c.Client.CreateNeighborNat(ctx, nat, opts...)
+	logMutation(c, "CreateNeighborNat", objectLogValues(nat), res, err)
+	return res, err
+}
+
+func (c *loggingClient) ListNats(ctx context.Context, natIP *netip.Addr, natType string, opts ...CallOption) (*api.NatList, error) {
+	res, err := c.Client.ListNats(ctx, natIP, natType, opts...)
+	logRead(c, "ListNats", []any{"nat_ip", addrLogValue(natIP), "nat_type", natType}, res, err)
+	return res, err
+}
+
+func (c *loggingClient) DeleteNeighborNat(ctx context.Context, neigbhorNat *api.NeighborNat, opts ...CallOption) (*api.NeighborNat, error) {
+	res, err := c.Client.DeleteNeighborNat(ctx, neigbhorNat, opts...)
+	logMutation(c, "DeleteNeighborNat", objectLogValues(neigbhorNat), res, err)
+	return res, err
+}
+
+func (c *loggingClient) ListNeighborNats(ctx context.Context, natIP *netip.Addr, opts ...CallOption) (*api.NatList, error) {
+	res, err := c.Client.ListNeighborNats(ctx, natIP, opts...)
+	logRead(c, "ListNeighborNats", []any{"nat_ip", addrLogValue(natIP)}, res, err)
+	return res, err
+}
+
+func (c *loggingClient) ListFirewallRules(ctx context.Context, interfaceID string, opts ...CallOption) (*api.FirewallRuleList, error) {
+	res, err := c.Client.ListFirewallRules(ctx, interfaceID, opts...)
+	logRead(c, "ListFirewallRules", []any{"interface", interfaceID}, res, err)
+	return res, err
+}
+
+func (c *loggingClient) CreateFirewallRule(ctx context.Context, fwRule *api.FirewallRule, opts ...CallOption) (*api.FirewallRule, error) {
+	res, err := c.Client.CreateFirewallRule(ctx, fwRule, opts...)
+	logMutation(c, "CreateFirewallRule", objectLogValues(fwRule), res, err)
+	return res, err
+}
+
+func (c *loggingClient) GetFirewallRule(ctx context.Context, interfaceID string, ruleID string, opts ...CallOption) (*api.FirewallRule, error) {
+	res, err := c.Client.GetFirewallRule(ctx, interfaceID, ruleID, opts...)
+	logRead(c, "GetFirewallRule", []any{"interface", interfaceID, "firewall_rule", ruleID}, res, err)
+	return res, err
+}
+
+func (c *loggingClient) DeleteFirewallRule(ctx context.Context, interfaceID string, ruleID string, opts ...CallOption) (*api.FirewallRule, error) {
+	res, err := c.Client.DeleteFirewallRule(ctx, interfaceID, ruleID, opts...)
+	logMutation(c, "DeleteFirewallRule", []any{"interface", interfaceID, "firewall_rule", ruleID}, res, err)
+	return res, err
+}
+
+func (c *loggingClient) CheckInitialized(ctx context.Context, opts ...CallOption) (*api.Initialized, error) {
+	res, err := c.Client.CheckInitialized(ctx, opts...)
+	logRead(c, "CheckInitialized", nil, res, err)
+	return res, err
+}
+
+func (c *loggingClient) Initialize(ctx context.Context, opts ...CallOption) (*api.Initialized, error) {
+	res, err := c.Client.Initialize(ctx, opts...)
+	logMutation(c, "Initialize", nil, res, err)
+	return res, err
+}
+
+func (c *loggingClient) GetVni(ctx context.Context, vni uint32, vniType uint8, opts ...CallOption) (*api.Vni, error) {
+	res, err := c.Client.GetVni(ctx, vni, vniType, opts...)
+	logRead(c, "GetVni", []any{"vni", vni, "vni_type", vniType}, res, err)
+	return res, err
+}
+
+func (c *loggingClient) ResetVni(ctx context.Context, vni uint32, vniType uint8, opts ...CallOption) (*api.Vni, error) {
+	res, err := c.Client.ResetVni(ctx, vni, vniType, opts...)
+	logMutation(c, "ResetVni", []any{"vni", vni, "vni_type", vniType}, res, err)
+	return res, err
+}
+
+func (c *loggingClient) GetVersion(ctx context.Context, version *api.Version, opts ...CallOption) (*api.Version, error) {
+	res, err := c.Client.GetVersion(ctx, version, opts...)
+	logRead(c, "GetVersion", objectLogValues(version), res, err)
+	return res, err
+}
+
+func (c *loggingClient) CaptureStart(ctx context.Context, capture *api.CaptureStart, opts ...CallOption) (*api.CaptureStart, error) {
+	res, err := c.Client.CaptureStart(ctx, capture, opts...)
+	logMutation(c, "CaptureStart", objectLogValues(capture), res, err)
+	if err == nil {
+		c.logSkippedCaptures(res)
+	}
+	return res, err
+}
+
+func (c *loggingClient) CaptureStop(ctx context.Context, opts ...CallOption) (*api.CaptureStop, error) {
+	res, err := c.Client.CaptureStop(ctx, opts...)
+	logMutation(c, "CaptureStop", nil, res, err)
+	return res, err
+}
+
+func (c *loggingClient) CaptureStatus(ctx context.Context, opts ...CallOption) (*api.CaptureStatus, error) {
+	res, err := c.Client.CaptureStatus(ctx, opts...)
+	logRead(c, "CaptureStatus", nil, res, err)
+	return res, err
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"github.com/go-logr/logr/funcr"
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/dpservicetest"
+	"github.com/ironcore-dev/dpservice-go/errors"
+	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("logging", Label("logging"), func() {
+	var (
+		server *dpservicetest.Server
+		lines  []string
+	)
+
+	BeforeEach(func() {
+		server = dpservicetest.NewServer()
+		server.Start()
+		DeferCleanup(server.Close)
+		lines = nil
+	})
+
+	newLoggingClient := func(ctx SpecContext, verbosity int, opts ...Option) Client {
+		conn, err := server.Dial(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(conn.Close)
+		log := funcr.New(func(prefix, args string) {
+			lines = append(lines, args)
+		}, funcr.Options{Verbosity: verbosity})
+		return NewClient(dpdkproto.NewDPDKironcoreClient(conn), append([]Option{WithLogger(log)}, opts...)...)
+	}
+
+	It("should log mutating calls and ignored status codes", func(ctx SpecContext) {
+		c := newLoggingClient(ctx, 2)
+		_, err := c.Initialize(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.CreateInterface(ctx, &api.Interface{
+			InterfaceMeta: api.InterfaceMeta{ID: "vm1"},
+			Spec:          api.InterfaceSpec{VNI: 100, Device: "net_tap2", IPv4: ptrAddr("10.200.1.4"), IPv6: ptrAddr("2000:200:1::4")},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.ListInterfaces(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.GetNat(ctx, "vm1", IgnoreErrors(errors.SNAT_NO_DATA))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.DeleteNat(ctx, "vm1")
+		Expect(err).To(HaveOccurred())
+
+		Expect(lines).To(HaveExactElements(
+			And(ContainSubstring(`"msg"="Called dp-service"`), ContainSubstring(`"method"="Initialize"`)),
+			And(ContainSubstring(`"method"="CreateInterface"`), ContainSubstring(`"interface"="vm1"`),
+				ContainSubstring(`"vni"=100`), ContainSubstring(`"ipv4"="10.200.1.4"`), ContainSubstring(`"code"=0`)),
+			And(ContainSubstring(`"msg"="Ignored dp-service status"`), ContainSubstring(`"method"="GetNat"`),
+				ContainSubstring(`"status"="SNAT_NO_DATA"`)),
+			And(ContainSubstring(`"msg"="dp-service returned an error status"`), ContainSubstring(`"method"="DeleteNat"`),
+				ContainSubstring(`"code"=`), ContainSubstring(`"interface"="vm1"`)),
+		))
+	})
+
+	It("should respect the configured verbosity", func(ctx SpecContext) {
+		c := newLoggingClient(ctx, 1, WithLogLevels(1, 3))
+		_, err := c.Initialize(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.GetNat(ctx, "vm1", IgnoreErrors(errors.NO_VM, errors.SNAT_NO_DATA))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(lines).To(ConsistOf(ContainSubstring(`"method"="Initialize"`)))
+	})
+
+	It("should log the interfaces skipped by a lenient capture", func(ctx SpecContext) {
+		c := newLoggingClient(ctx, 0)
+		_, err := c.Initialize(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.CaptureStart(ctx, &api.CaptureStart{
+			CaptureStartMeta: api.CaptureStartMeta{
+				Config: &api.CaptureConfig{SinkNodeIP: ptrAddr("fc00:2::64:0:1"), UdpSrcPort: 500, UdpDstPort: 1000},
+			},
+			Spec: api.CaptureStartSpec{Interfaces: []api.CaptureInterface{
+				{InterfaceType: "pf", InterfaceInfo: "0"},
+				{InterfaceType: "xf", InterfaceInfo: "net_tap2"},
+			}},
+		}, WithLenientCapture())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lines).To(ConsistOf(And(
+			ContainSubstring(`"msg"="Skipped invalid captured interface"`),
+			ContainSubstring(`"interface"="net_tap2"`),
+			ContainSubstring(`"type"="xf"`),
+		)))
+	})
+})
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RestartEvent is emitted by WatchRestarts when dp-service lost its configuration.
+type RestartEvent struct {
+	// Previous is the last state observed before the restart.
+	Previous *api.Initialized
+	// Current is the state after the restart. It is nil if dp-service is running
+	// but has not been initialized again yet, in which case Initialize has to be
+	// called before the configuration can be replayed.
+	Current *api.Initialized
+}
+
+// WatchRestarts polls CheckInitialized every interval and emits an event whenever
+// the UUID reported by dp-service changes or dp-service reports it is not initialized
+// anymore. The first successful poll establishes the baseline and emits nothing.
+// Transport errors, e.g. while dp-service is down, are ignored and polling continues.
+// The returned channel is closed when ctx is done.
+func WatchRestarts(ctx context.Context, c Client, interval time.Duration) <-chan RestartEvent {
+	events := make(chan RestartEvent)
+
+	go func() {
+		defer close(events)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		var last *api.Initialized
+		for {
+			current, err := c.CheckInitialized(ctx)
+			switch {
+			case err == nil:
+				if last != nil && last.Spec.UUID != current.Spec.UUID {
+					if !sendRestartEvent(ctx, events, RestartEvent{Previous: last, Current: current}) {
+						return
+					}
+				}
+				last = current
+			case isNotInitialized(err):
+				if last != nil {
+					if !sendRestartEvent(ctx, events, RestartEvent{Previous: last}) {
+						return
+					}
+				}
+				// The next UUID belongs to the restart that was just reported.
+				last = nil
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return events
+}
+
+func sendRestartEvent(ctx context.Context, events chan<- RestartEvent, event RestartEvent) bool {
+	select {
+	case events <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// isNotInitialized reports whether dp-service rejected a call because Initialize was not called yet.
+func isNotInitialized(err error) bool {
+	return status.Code(err) == codes.Aborted
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/ironcore-dev/dpservice-go/dpservicetest"
+	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("restart watcher", Label("restart"), func() {
+	var server *dpservicetest.Server
+	var c Client
+
+	BeforeEach(func(ctx SpecContext) {
+		server = dpservicetest.NewServer()
+		server.Start()
+		DeferCleanup(server.Close)
+
+		conn, err := server.Dial(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(conn.Close)
+		c = NewClient(dpdkproto.NewDPDKironcoreClient(conn))
+	})
+
+	It("should report a restart and the new uuid", func(ctx SpecContext) {
+		init, err := c.Initialize(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		events := WatchRestarts(watchCtx, c, 10*time.Millisecond)
+
+		By("not reporting anything while dp-service keeps running")
+		Consistently(events, 50*time.Millisecond).ShouldNot(Receive())
+
+		By("reporting the restart while dp-service is not initialized")
+		server.Restart()
+		var event RestartEvent
+		Eventually(events).Should(Receive(&event))
+		Expect(event.Previous.Spec.UUID).To(Equal(init.Spec.UUID))
+		Expect(event.Current).To(BeNil())
+
+		By("not reporting the initialization following the restart")
+		_, err = c.Initialize(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Consistently(events, 50*time.Millisecond).ShouldNot(Receive())
+
+		By("closing the channel when the context is done")
+		cancel()
+		Eventually(events).Should(BeClosed())
+	})
+})
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/ironcore-dev/dpservice-go/errors"
+	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
+	"google.golang.org/grpc"
+)
+
+// RetryPolicy configures how calls to dp-service are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts per call, including the first one.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts.
+	MaxBackoff time.Duration
+	// Multiplier increases the delay after every retry.
+	Multiplier float64
+	// Jitter randomizes every delay by up to this fraction, e.g. 0.2 for ±20%.
+	Jitter float64
+	// Retryable decides whether a failed attempt is retried, errors.IsRetryable if nil.
+	// It is called with the transport error or, if the call succeeded, with a
+	// StatusError carrying the status code returned by dp-service.
+	Retryable func(error) bool
+}
+
+// DefaultRetryPolicy retries up to 5 times with an exponential backoff from 100ms to 2s.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    5,
+	InitialBackoff: 100 * time.Millisecond,
+	MaxBackoff:     2 * time.Second,
+	Multiplier:     2,
+	Jitter:         0.2,
+}
+
+// WithRetry retries calls failing with a transient error according to policy.
+// Note that a create that is retried after a transport error might already have
+// been applied by dp-service, in which case the retry fails with ALREADY_EXISTS.
+func WithRetry(policy RetryPolicy) Option {
+	return func(o *options) {
+		o.retryPolicy = &policy
+	}
+}
+
+// RetryInterceptor returns a gRPC interceptor retrying calls according to policy,
+// for clients created with NewClient on a connection set up by the caller.
+// Status codes reported by dp-service in the response are classified as well.
+func RetryInterceptor(policy RetryPolicy) grpc.UnaryClientInterceptor {
+	retryable := policy.Retryable
+	if retryable == nil {
+		retryable = errors.IsRetryable
+	}
+
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		backoff := policy.InitialBackoff
+		for attempt := 1; ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if attempt >= policy.MaxAttempts || ctx.Err() != nil || !retryable(attemptError(err, reply)) {
+				return err
+			}
+
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(policy.jitter(backoff)):
+			}
+
+			backoff = time.Duration(float64(backoff) * policy.Multiplier)
+			if policy.MaxBackoff > 0 && backoff > policy.MaxBackoff {
+				backoff = policy.MaxBackoff
+			}
+		}
+	}
+}
+
+// attemptError returns the transport error or the status reported by dp-service.
+func attemptError(err error, reply interface{}) error {
+	if err != nil {
+		return err
+	}
+	res, ok := reply.(interface{ GetStatus() *dpdkproto.Status })
+	if !ok || res.GetStatus().GetCode() == 0 {
+		return nil
+	}
+	return errors.NewStatusError(res.GetStatus().GetCode(), res.GetStatus().GetMessage())
+}
+
+func (p *RetryPolicy) jitter(d time.Duration) time.Duration {
+	if p.Jitter <= 0 {
+		return d
+	}
+	return time.Duration(float64(d) * (1 + p.Jitter*(2*rand.Float64()-1)))
+}
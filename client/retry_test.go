@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path/filepath"
+	"time"
+
+	"github.com/ironcore-dev/dpservice-go/dpservicetest"
+	"github.com/ironcore-dev/dpservice-go/errors"
+	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("retry", Label("retry"), func() {
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, Multiplier: 2}
+
+	DescribeTable("classifying errors",
+		func(err error, retryable bool) {
+			Expect(errors.IsRetryable(err)).To(Equal(retryable))
+		},
+		Entry("nil", nil, false),
+		Entry("unavailable", status.Error(codes.Unavailable, "connection refused"), true),
+		Entry("deadline exceeded", status.Error(codes.DeadlineExceeded, "timeout"), true),
+		Entry("invalid argument", status.Error(codes.InvalidArgument, "Invalid interface_id"), false),
+		Entry("out of memory", errors.NewStatusError(errors.OUT_OF_MEMORY, "OUT_OF_MEMORY"), true),
+		Entry("wrapped rollback", fmt.Errorf("error creating nat: %w", errors.NewStatusError(errors.ROLLBACK, "")), true),
+		Entry("already exists", errors.NewStatusError(errors.ALREADY_EXISTS, "ALREADY_EXISTS"), false),
+		Entry("not found", errors.NewStatusError(errors.NOT_FOUND, "NOT_FOUND"), false),
+	)
+
+	// invoke calls the interceptor with an invoker returning the given status codes one after the other.
+	invoke := func(policy RetryPolicy, results ...uint32) (*dpdkproto.CreateNatResponse, int, error) {
+		attempts := 0
+		reply := &dpdkproto.CreateNatResponse{}
+		err := RetryInterceptor(policy)(context.TODO(), "/dpdkironcore.v1.DPDKironcore/CreateNat", &dpdkproto.CreateNatRequest{}, reply, nil,
+			func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				code := results[attempts]
+				attempts++
+				reply.(*dpdkproto.CreateNatResponse).Status = &dpdkproto.Status{Code: code}
+				return nil
+			})
+		return reply, attempts, err
+	}
+
+	It("should retry transient dp-service status codes", func() {
+		reply, attempts, err := invoke(policy, errors.ITERATOR, errors.RTE_RULE_ADD, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(attempts).To(Equal(3))
+		Expect(reply.Status.Code).To(BeZero())
+	})
+
+	It("should not retry permanent dp-service status codes", func() {
+		reply, attempts, err := invoke(policy, errors.ALREADY_EXISTS, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(attempts).To(Equal(1))
+		Expect(reply.Status.Code).To(Equal(uint32(errors.ALREADY_EXISTS)))
+	})
+
+	It("should give up after MaxAttempts", func() {
+		reply, attempts, err := invoke(policy, errors.OUT_OF_MEMORY, errors.OUT_OF_MEMORY, errors.OUT_OF_MEMORY, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(attempts).To(Equal(3))
+		Expect(reply.Status.Code).To(Equal(uint32(errors.OUT_OF_MEMORY)))
+	})
+
+	It("should retry unavailable dp-service when dialed with WithRetry", func(ctx SpecContext) {
+		socket := filepath.Join(GinkgoT().TempDir(), "dpservice.sock")
+		lis, err := net.Listen("unix", socket)
+		Expect(err).NotTo(HaveOccurred())
+
+		failures := 2
+		srv := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			if failures > 0 {
+				failures--
+				return nil, status.Error(codes.Unavailable, "dp-service is starting")
+			}
+			return handler(ctx, req)
+		}))
+		dpdkproto.RegisterDPDKironcoreServer(srv, dpservicetest.NewServer())
+		go func() {
+			_ = srv.Serve(lis)
+		}()
+		DeferCleanup(srv.Stop)
+
+		c, err := Dial(ctx, socket, WithRetry(policy))
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(c.Close)
+
+		_, err = c.Initialize(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(failures).To(BeZero())
+	})
+})
@@ -5,14 +5,16 @@ package client
 
 import (
 	"context"
+	"net/netip"
+	"os"
 	"testing"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/ironcore-dev/dpservice-go/dpservicetest"
 	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
 	//+kubebuilder:scaffold:imports
 )
@@ -23,13 +25,15 @@ import (
 var (
 	ctxCancel       context.CancelFunc
 	ctxGrpc         context.Context
-	dpserviceAddr   string = "127.0.0.1:1337"
+	dpserviceAddr   string = os.Getenv("DPSERVICE_ADDR")
 	dpdkProtoClient dpdkproto.DPDKironcoreClient
 	dpdkClient      Client
+	fakeServer      *dpservicetest.Server
 )
 
-// This assumes running dp-service on the same localhost of this test suite
+// If DPSERVICE_ADDR is set (e.g. to 127.0.0.1:1337), this runs against a real dp-service:
 // /test/dp_service.py --no-init
+// Otherwise the suite runs against the in-memory fake from the dpservicetest package.
 
 func TestGrpcFuncs(t *testing.T) {
 	RegisterFailHandler(Fail)
@@ -43,16 +47,49 @@ var _ = BeforeSuite(func() {
 	// setup net-dpservice client
 	ctxGrpc, ctxCancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
 
-	conn, err := grpc.DialContext(ctxGrpc, dpserviceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
-	Expect(err).NotTo(HaveOccurred())
-
-	dpdkProtoClient = dpdkproto.NewDPDKironcoreClient(conn)
-	dpdkClient = NewClient(dpdkProtoClient)
+	var err error
+	if dpserviceAddr != "" {
+		dpdkClient, err = Dial(ctxGrpc, dpserviceAddr)
+		Expect(err).NotTo(HaveOccurred())
+	} else {
+		fakeServer = dpservicetest.NewServer()
+		fakeServer.Start()
+		conn, err := fakeServer.Dial(ctxGrpc, grpc.WithBlock())
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(conn.Close)
+
+		dpdkProtoClient = dpdkproto.NewDPDKironcoreClient(conn)
+		dpdkClient = NewClient(dpdkProtoClient)
+	}
 
 	_, err = dpdkClient.Initialize(context.TODO())
 	Expect(err).NotTo(HaveOccurred())
 })
 
 var _ = AfterSuite(func() {
-
+	Expect(dpdkClient.Close()).To(Succeed())
+	if fakeServer != nil {
+		fakeServer.Close()
+	}
 })
+
+// newFakeClient returns an initialized Client of a fake dp-service started for the current spec.
+func newFakeClient(ctx context.Context, opts ...grpc.DialOption) Client {
+	server := dpservicetest.NewServer()
+	server.Start()
+	DeferCleanup(server.Close)
+
+	conn, err := server.Dial(ctx, opts...)
+	Expect(err).NotTo(HaveOccurred())
+	DeferCleanup(conn.Close)
+
+	c := NewClient(dpdkproto.NewDPDKironcoreClient(conn))
+	_, err = c.Initialize(ctx)
+	Expect(err).NotTo(HaveOccurred())
+	return c
+}
+
+func ptrAddr(s string) *netip.Addr {
+	addr := netip.MustParseAddr(s)
+	return &addr
+}
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"sync"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+)
+
+// Tx creates objects in several steps and deletes the created ones in reverse order
+// if a step fails or Rollback is called.
+type Tx struct {
+	c    Client
+	opts []CallOption
+
+	mu    sync.Mutex
+	steps []txStep
+}
+
+type txStep struct {
+	kind string
+	name string
+	undo func(ctx context.Context) error
+}
+
+// NewTx returns a Tx creating objects with c, opts are passed to every call.
+func NewTx(c Client, opts ...CallOption) *Tx {
+	return &Tx{c: c, opts: opts}
+}
+
+// RunTx calls fn with a new Tx and rolls it back if fn returns an error.
+func RunTx(ctx context.Context, c Client, fn func(tx *Tx) error, opts ...CallOption) error {
+	tx := NewTx(c, opts...)
+	if err := fn(tx); err != nil {
+		return stderrors.Join(err, tx.Rollback(ctx))
+	}
+	tx.Commit()
+	return nil
+}
+
+// do records undo for obj if the step created it, otherwise it rolls back the Tx.
+func (tx *Tx) do(ctx context.Context, kind string, obj api.Object, err error, undo func(ctx context.Context) error) error {
+	if err != nil {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+			return stderrors.Join(err, rollbackErr)
+		}
+		return err
+	}
+	// Objects whose creation failed with an ignored status code existed before and are kept.
+	if obj.GetStatus().Code != 0 {
+		return nil
+	}
+
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	tx.steps = append(tx.steps, txStep{kind: kind, name: obj.GetName(), undo: undo})
+	return nil
+}
+
+// Rollback deletes the objects created by the Tx in reverse order. It continues after errors
+// and returns all of them. The Tx is empty afterwards and can be reused.
+func (tx *Tx) Rollback(ctx context.Context) error {
+	tx.mu.Lock()
+	steps := tx.steps
+	tx.steps = nil
+	tx.mu.Unlock()
+
+	// Roll back even if ctx was canceled, the cancellation may be why a step failed.
+	ctx = context.WithoutCancel(ctx)
+	var errs []error
+	for i := len(steps) - 1; i >= 0; i-- {
+		if err := steps[i].undo(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("error rolling back %s %s: %w", steps[i].kind, steps[i].name, err))
+		}
+	}
+	return stderrors.Join(errs...)
+}
+
+// Commit forgets the created objects, a later Rollback keeps them.
+func (tx *Tx) Commit() {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	tx.steps = nil
+}
+
+// CreateInterface creates iface and records DeleteInterface as its inverse.
+func (tx *Tx) CreateInterface(ctx context.Context, iface *api.Interface) (*api.Interface, error) {
+	res, err := tx.c.CreateInterface(ctx, iface, tx.opts...)
+	return res, tx.do(ctx, api.InterfaceKind, res, err, func(ctx context.Context) error {
+		_, err := tx.c.DeleteInterface(ctx, iface.ID, tx.opts...)
+		return err
+	})
+}
+
+// CreatePrefix creates prefix and records DeletePrefix as its inverse.
+func (tx *Tx) CreatePrefix(ctx context.Context, prefix *api.Prefix) (*api.Prefix, error) {
+	res, err := tx.c.CreatePrefix(ctx, prefix, tx.opts...)
+	return res, tx.do(ctx, api.PrefixKind, res, err, func(ctx context.Context) error {
+		_, err := tx.c.DeletePrefix(ctx, prefix.InterfaceID, &prefix.Spec.Prefix, tx.opts...)
+		return err
+	})
+}
+
+// CreateLoadBalancerPrefix creates prefix and records DeleteLoadBalancerPrefix as its inverse.
+func (tx *Tx) CreateLoadBalancerPrefix(ctx context.Context, prefix *api.LoadBalancerPrefix) (*api.LoadBalancerPrefix, error) {
+	res, err := tx.c.CreateLoadBalancerPrefix(ctx, prefix, tx.opts...)
+	return res, tx.do(ctx, api.LoadBalancerPrefixKind, res, err, func(ctx context.Context) error {
+		_, err := tx.c.DeleteLoadBalancerPrefix(ctx, prefix.InterfaceID, &prefix.Spec.Prefix, tx.opts...)
+		return err
+	})
+}
+
+// CreateVirtualIP creates vip and records DeleteVirtualIP as its inverse.
+func (tx *Tx) CreateVirtualIP(ctx context.Context, vip *api.VirtualIP) (*api.VirtualIP, error) {
+	res, err := tx.c.CreateVirtualIP(ctx, vip, tx.opts...)
+	return res, tx.do(ctx, api.VirtualIPKind, res, err, func(ctx context.Context) error {
+		_, err := tx.c.DeleteVirtualIP(ctx, vip.InterfaceID, tx.opts...)
+		return err
+	})
+}
+
+// CreateNat creates nat and records DeleteNat as its inverse.
+func (tx *Tx) CreateNat(ctx context.Context, nat *api.Nat) (*api.Nat, error) {
+	res, err := tx.c.CreateNat(ctx, nat, tx.opts...)
+	return res, tx.do(ctx, api.NatKind, res, err, func(ctx context.Context) error {
+		_, err := tx.c.DeleteNat(ctx, nat.InterfaceID, tx.opts...)
+		return err
+	})
+}
+
+// CreateFirewallRule creates rule and records DeleteFirewallRule as its inverse.
+func (tx *Tx) CreateFirewallRule(ctx context.Context, rule *api.FirewallRule) (*api.FirewallRule, error) {
+	res, err := tx.c.CreateFirewallRule(ctx, rule, tx.opts...)
+	return res, tx.do(ctx, api.FirewallRuleKind, res, err, func(ctx context.Context) error {
+		_, err := tx.c.DeleteFirewallRule(ctx, rule.InterfaceID, rule.Spec.RuleID, tx.opts...)
+		return err
+	})
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"net/netip"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/errors"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("tx", Label("tx"), func() {
+	var c Client
+
+	BeforeEach(func(ctx SpecContext) {
+		c = newFakeClient(ctx)
+	})
+
+	iface := &api.Interface{
+		InterfaceMeta: api.InterfaceMeta{ID: "vm6"},
+		Spec: api.InterfaceSpec{
+			VNI:    600,
+			Device: "net_tap7",
+			IPv4:   ptrAddr("10.200.6.4"),
+			IPv6:   ptrAddr("2000:200:6::4"),
+		},
+	}
+	prefix := &api.Prefix{
+		PrefixMeta: api.PrefixMeta{InterfaceID: "vm6"},
+		Spec:       api.PrefixSpec{Prefix: netip.MustParsePrefix("10.60.0.0/24")},
+	}
+	vip := &api.VirtualIP{
+		VirtualIPMeta: api.VirtualIPMeta{InterfaceID: "vm6"},
+		Spec:          api.VirtualIPSpec{IP: ptrAddr("20.60.0.1")},
+	}
+
+	It("should undo the created objects if a step fails", func(ctx SpecContext) {
+		tx := NewTx(c)
+		_, err := tx.CreateInterface(ctx, iface)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = tx.CreatePrefix(ctx, prefix)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = tx.CreateVirtualIP(ctx, vip)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = tx.CreateFirewallRule(ctx, &api.FirewallRule{
+			FirewallRuleMeta: api.FirewallRuleMeta{InterfaceID: "vm6"},
+			Spec:             api.FirewallRuleSpec{RuleID: "fr6"},
+		})
+		Expect(err).To(HaveOccurred())
+
+		_, err = c.GetInterface(ctx, "vm6")
+		Expect(errors.IsStatusErrorCode(err, errors.NOT_FOUND)).To(BeTrue())
+		prefixes, err := c.ListPrefixes(ctx, "vm6")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(prefixes.Items).To(BeEmpty())
+		_, err = c.GetVirtualIP(ctx, "vm6")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should keep committed objects and roll back explicitly", func(ctx SpecContext) {
+		tx := NewTx(c)
+		_, err := tx.CreateInterface(ctx, iface)
+		Expect(err).NotTo(HaveOccurred())
+		tx.Commit()
+
+		_, err = tx.CreatePrefix(ctx, prefix)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tx.Rollback(ctx)).To(Succeed())
+
+		_, err = c.GetInterface(ctx, "vm6")
+		Expect(err).NotTo(HaveOccurred())
+		prefixes, err := c.ListPrefixes(ctx, "vm6")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(prefixes.Items).To(BeEmpty())
+	})
+
+	It("should not delete objects which existed before", func(ctx SpecContext) {
+		_, err := c.CreateInterface(ctx, iface)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = RunTx(ctx, c, func(tx *Tx) error {
+			if _, err := tx.CreateInterface(ctx, iface); err != nil {
+				return err
+			}
+			_, err := tx.CreatePrefix(ctx, prefix)
+			Expect(err).NotTo(HaveOccurred())
+			return errors.ErrNoVM
+		}, IgnoreErrors(errors.ALREADY_EXISTS))
+		Expect(err).To(MatchError(errors.ErrNoVM))
+
+		_, err = c.GetInterface(ctx, "vm6")
+		Expect(err).NotTo(HaveOccurred())
+		prefixes, err := c.ListPrefixes(ctx, "vm6")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(prefixes.Items).To(BeEmpty())
+	})
+})
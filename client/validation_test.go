@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("validation", Label("validation"), func() {
+	ctx := context.TODO()
+
+	It("should reject invalid objects before calling dp-service", func() {
+		By("using a prefix with host bits set")
+		prefix := netip.MustParsePrefix("10.20.30.1/24")
+		nextHop := netip.MustParseAddr("fc00:2::64:0:1")
+		_, err := dpdkClient.CreateRoute(ctx, &api.Route{
+			RouteMeta: api.RouteMeta{VNI: 1 << 24},
+			Spec: api.RouteSpec{
+				Prefix:  &prefix,
+				NextHop: &api.RouteNextHop{IP: &nextHop},
+			},
+		})
+		Expect(err).To(MatchError("invalid Route: metadata.vni: must be at most 16777215, " +
+			"spec.prefix: must not have host bits set, use 10.20.30.0/24"))
+
+		By("using an unaligned NAT port range")
+		natIP := netip.MustParseAddr("10.20.30.40")
+		_, err = dpdkClient.CreateNat(ctx, &api.Nat{
+			NatMeta: api.NatMeta{InterfaceID: negativeTestIfaceID},
+			Spec:    api.NatSpec{NatIP: &natIP, MinPort: 100, MaxPort: 300},
+		})
+		Expect(err).To(MatchError("invalid Nat: spec.min_port: must be a multiple of the range size 200"))
+
+		By("using an unsupported load balancer protocol")
+		lbIP := netip.MustParseAddr("10.20.30.50")
+		_, err = dpdkClient.CreateLoadBalancer(ctx, &api.LoadBalancer{
+			LoadBalancerMeta: api.LoadBalancerMeta{ID: "lb1"},
+			Spec: api.LoadBalancerSpec{
+				VNI:     100,
+				LbVipIP: &lbIP,
+				Lbports: []api.LBPort{{Protocol: 6, Port: 443}, {Protocol: 58, Port: 70000}},
+			},
+		})
+		Expect(err).To(MatchError("invalid LoadBalancer: spec.loadbalanced_ports[1].protocol: must be TCP (6) or UDP (17), " +
+			"spec.loadbalanced_ports[1].port: must be at most 65535"))
+
+		By("using an IPv4 load balancer target")
+		_, err = dpdkClient.CreateLoadBalancerTarget(ctx, &api.LoadBalancerTarget{
+			LoadBalancerTargetMeta: api.LoadBalancerTargetMeta{LoadbalancerID: "lb1"},
+			Spec:                   api.LoadBalancerTargetSpec{TargetIP: &natIP},
+		})
+		Expect(err).To(MatchError("invalid LoadBalancerTarget: spec.target_ip: must be an IPv6 address"))
+
+		By("deleting without a prefix")
+		_, err = dpdkClient.DeletePrefix(ctx, negativeTestIfaceID, nil)
+		Expect(err).To(MatchError("prefix needs to be specified"))
+	})
+
+	It("should report every invalid field", func() {
+		var validationErr *api.ValidationError
+		err := (&api.InterfaceSpec{VNI: 1 << 24}).Validate()
+		Expect(err).To(BeAssignableToTypeOf(validationErr))
+		Expect(err.(*api.ValidationError).Errors).To(HaveLen(4))
+
+		Expect((&api.CaptureStartSpec{Interfaces: []api.CaptureInterface{
+			{InterfaceType: "pf", InterfaceInfo: "0"},
+			{InterfaceType: "pf", InterfaceInfo: "vm1"},
+			{InterfaceType: "xx", InterfaceInfo: "vm1"},
+		}}).Validate()).To(MatchError("invalid CaptureStartSpec: interfaces[1].interface_info: must be a pf index, " +
+			"interfaces[2].interface_type: must be pf or vf"))
+	})
+
+	It("should check the interface type", func() {
+		ipv4 := netip.MustParseAddr("10.200.1.4")
+		ipv6 := netip.MustParseAddr("2000:200:1::4")
+		spec := api.InterfaceSpec{Type: "container", VNI: 200, Device: "net_tap5", IPv4: &ipv4, IPv6: &ipv6}
+		Expect(spec.Validate()).To(MatchError("invalid InterfaceSpec: type: must be virtual or baremetal"))
+
+		spec.Type = api.InterfaceTypeBareMetal
+		Expect(spec.Validate()).To(Succeed())
+		spec.VirtualFunction = &api.VirtualFunction{Name: "net_tap5"}
+		Expect(spec.Validate()).To(MatchError("invalid InterfaceSpec: virtual_function: must not be set for baremetal interfaces"))
+	})
+})
@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/errors"
+)
+
+// VMNetworkSpec describes the networking of a VM.
+type VMNetworkSpec struct {
+	// ID is the ID of the interface.
+	ID string
+	// Interface is the spec of the interface including PXE and metering parameters.
+	Interface api.InterfaceSpec
+	// Prefixes are the alias prefixes routed to the interface.
+	Prefixes []netip.Prefix
+	// LoadBalancerPrefixes are the prefixes load balanced to the interface.
+	LoadBalancerPrefixes []netip.Prefix
+	// VirtualIP is the public IP of the interface, if any.
+	VirtualIP *netip.Addr
+	// Nat is the NAT IP and port range of the interface, if any.
+	Nat           *api.NatSpec
+	FirewallRules []api.FirewallRuleSpec
+}
+
+// VMNetwork is the networking of a VM created by AttachVM.
+type VMNetwork struct {
+	// VirtualFunction is the name of the virtual function the VM has to use.
+	VirtualFunction string
+	// UnderlayRoute is the underlay route of the interface.
+	UnderlayRoute *netip.Addr
+
+	Interface            *api.Interface
+	Prefixes             []*api.Prefix
+	LoadBalancerPrefixes []*api.LoadBalancerPrefix
+	VirtualIP            *api.VirtualIP
+	Nat                  *api.Nat
+	FirewallRules        []*api.FirewallRule
+}
+
+// AttachVM creates the interface of a VM, its prefixes, load balancer prefixes, virtual IP, NAT and
+// firewall rules in this order. If a step fails, the objects created until then are deleted again.
+func AttachVM(ctx context.Context, c Client, spec VMNetworkSpec, opts ...CallOption) (*VMNetwork, error) {
+	tx := NewTx(c, opts...)
+	vm := &VMNetwork{}
+
+	iface, err := tx.CreateInterface(ctx, &api.Interface{
+		TypeMeta:      api.TypeMeta{Kind: api.InterfaceKind},
+		InterfaceMeta: api.InterfaceMeta{ID: spec.ID},
+		Spec:          spec.Interface,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating interface: %w", err)
+	}
+	vm.Interface = iface
+	vm.UnderlayRoute = iface.Spec.UnderlayRoute
+	if iface.Spec.VirtualFunction != nil {
+		vm.VirtualFunction = iface.Spec.VirtualFunction.Name
+	}
+
+	for _, prefix := range spec.Prefixes {
+		res, err := tx.CreatePrefix(ctx, &api.Prefix{
+			TypeMeta:   api.TypeMeta{Kind: api.PrefixKind},
+			PrefixMeta: api.PrefixMeta{InterfaceID: spec.ID},
+			Spec:       api.PrefixSpec{Prefix: prefix},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error creating prefix %s: %w", prefix, err)
+		}
+		vm.Prefixes = append(vm.Prefixes, res)
+	}
+
+	for _, prefix := range spec.LoadBalancerPrefixes {
+		res, err := tx.CreateLoadBalancerPrefix(ctx, &api.LoadBalancerPrefix{
+			TypeMeta:               api.TypeMeta{Kind: api.LoadBalancerPrefixKind},
+			LoadBalancerPrefixMeta: api.LoadBalancerPrefixMeta{InterfaceID: spec.ID},
+			Spec:                   api.LoadBalancerPrefixSpec{Prefix: prefix},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error creating loadbalancer prefix %s: %w", prefix, err)
+		}
+		vm.LoadBalancerPrefixes = append(vm.LoadBalancerPrefixes, res)
+	}
+
+	if spec.VirtualIP != nil {
+		vm.VirtualIP, err = tx.CreateVirtualIP(ctx, &api.VirtualIP{
+			TypeMeta:      api.TypeMeta{Kind: api.VirtualIPKind},
+			VirtualIPMeta: api.VirtualIPMeta{InterfaceID: spec.ID},
+			Spec:          api.VirtualIPSpec{IP: spec.VirtualIP},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error creating virtual ip: %w", err)
+		}
+	}
+
+	if spec.Nat != nil {
+		vm.Nat, err = tx.CreateNat(ctx, &api.Nat{
+			TypeMeta: api.TypeMeta{Kind: api.NatKind},
+			NatMeta:  api.NatMeta{InterfaceID: spec.ID},
+			Spec:     *spec.Nat,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error creating nat: %w", err)
+		}
+	}
+
+	for _, rule := range spec.FirewallRules {
+		res, err := tx.CreateFirewallRule(ctx, &api.FirewallRule{
+			TypeMeta:         api.TypeMeta{Kind: api.FirewallRuleKind},
+			FirewallRuleMeta: api.FirewallRuleMeta{InterfaceID: spec.ID},
+			Spec:             rule,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error creating firewall rule %s: %w", rule.RuleID, err)
+		}
+		vm.FirewallRules = append(vm.FirewallRules, res)
+	}
+
+	tx.Commit()
+	return vm, nil
+}
+
+// detachIgnoredErrors are the status codes of objects which are already gone.
+var detachIgnoredErrors = IgnoreErrors(errors.NOT_FOUND, errors.NO_VM, errors.SNAT_NO_DATA, errors.ROUTE_NOT_FOUND)
+
+// DetachVM deletes the firewall rules, NAT, virtual IP, load balancer prefixes, prefixes and
+// the interface of a VM in this order. Objects which are already gone are skipped.
+func DetachVM(ctx context.Context, c Client, id string, opts ...CallOption) error {
+	opts = append(opts, detachIgnoredErrors)
+
+	rules, err := c.ListFirewallRules(ctx, id, opts...)
+	if err != nil {
+		return fmt.Errorf("error listing firewall rules: %w", err)
+	}
+	for _, rule := range rules.Items {
+		if _, err := c.DeleteFirewallRule(ctx, id, rule.Spec.RuleID, opts...); err != nil {
+			return fmt.Errorf("error deleting firewall rule %s: %w", rule.Spec.RuleID, err)
+		}
+	}
+
+	if _, err := c.DeleteNat(ctx, id, opts...); err != nil {
+		return fmt.Errorf("error deleting nat: %w", err)
+	}
+	if _, err := c.DeleteVirtualIP(ctx, id, opts...); err != nil {
+		return fmt.Errorf("error deleting virtual ip: %w", err)
+	}
+
+	lbPrefixes, err := c.ListLoadBalancerPrefixes(ctx, id, opts...)
+	if err != nil {
+		return fmt.Errorf("error listing loadbalancer prefixes: %w", err)
+	}
+	for _, prefix := range lbPrefixes.Items {
+		if _, err := c.DeleteLoadBalancerPrefix(ctx, id, &prefix.Spec.Prefix, opts...); err != nil {
+			return fmt.Errorf("error deleting loadbalancer prefix %s: %w", prefix.Spec.Prefix, err)
+		}
+	}
+
+	prefixes, err := c.ListPrefixes(ctx, id, opts...)
+	if err != nil {
+		return fmt.Errorf("error listing prefixes: %w", err)
+	}
+	for _, prefix := range prefixes.Items {
+		if _, err := c.DeletePrefix(ctx, id, &prefix.Spec.Prefix, opts...); err != nil {
+			return fmt.Errorf("error deleting prefix %s: %w", prefix.Spec.Prefix, err)
+		}
+	}
+
+	if _, err := c.DeleteInterface(ctx, id, opts...); err != nil {
+		return fmt.Errorf("error deleting interface: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"net/netip"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/errors"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("vm", Label("vm"), func() {
+	var c Client
+
+	BeforeEach(func(ctx SpecContext) {
+		c = newFakeClient(ctx)
+	})
+
+	anyPrefix := netip.MustParsePrefix("0.0.0.0/0")
+	newSpec := func() VMNetworkSpec {
+		return VMNetworkSpec{
+			ID: "vm7",
+			Interface: api.InterfaceSpec{
+				VNI:    700,
+				Device: "net_tap8",
+				IPv4:   ptrAddr("10.200.7.4"),
+				IPv6:   ptrAddr("2000:200:7::4"),
+				PXE:    &api.PXE{Server: "10.200.7.1", FileName: "ipxe"},
+			},
+			Prefixes:             []netip.Prefix{netip.MustParsePrefix("10.70.0.0/24")},
+			LoadBalancerPrefixes: []netip.Prefix{netip.MustParsePrefix("10.71.0.1/32")},
+			VirtualIP:            ptrAddr("20.70.0.1"),
+			FirewallRules: []api.FirewallRuleSpec{{
+				RuleID:            "fr7",
+				TrafficDirection:  "ingress",
+				FirewallAction:    "accept",
+				Priority:          1000,
+				SourcePrefix:      &anyPrefix,
+				DestinationPrefix: &anyPrefix,
+			}},
+		}
+	}
+
+	It("should attach and detach a VM", func(ctx SpecContext) {
+		vm, err := AttachVM(ctx, c, newSpec())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vm.VirtualFunction).NotTo(BeEmpty())
+		Expect(vm.UnderlayRoute).NotTo(BeNil())
+		Expect(vm.Prefixes).To(HaveLen(1))
+		Expect(vm.Prefixes[0].Spec.UnderlayRoute).NotTo(BeNil())
+		Expect(vm.LoadBalancerPrefixes).To(HaveLen(1))
+		Expect(vm.VirtualIP.Spec.UnderlayRoute).NotTo(BeNil())
+		Expect(vm.FirewallRules).To(HaveLen(1))
+
+		rules, err := c.ListFirewallRules(ctx, "vm7")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rules.Items).To(HaveLen(1))
+
+		Expect(DetachVM(ctx, c, "vm7")).To(Succeed())
+		_, err = c.GetInterface(ctx, "vm7")
+		Expect(errors.IsStatusErrorCode(err, errors.NOT_FOUND)).To(BeTrue())
+
+		Expect(DetachVM(ctx, c, "vm7")).To(Succeed())
+	})
+
+	It("should detach a partially removed VM", func(ctx SpecContext) {
+		_, err := AttachVM(ctx, c, newSpec())
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.DeleteVirtualIP(ctx, "vm7")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.DeleteFirewallRule(ctx, "vm7", "fr7")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(DetachVM(ctx, c, "vm7")).To(Succeed())
+		_, err = c.GetInterface(ctx, "vm7")
+		Expect(errors.IsStatusErrorCode(err, errors.NOT_FOUND)).To(BeTrue())
+	})
+
+	It("should delete the created objects if attaching fails", func(ctx SpecContext) {
+		spec := newSpec()
+		spec.FirewallRules[0].SourcePrefix = nil
+
+		_, err := AttachVM(ctx, c, spec)
+		Expect(err).To(MatchError(ContainSubstring("error creating firewall rule fr7")))
+
+		_, err = c.GetInterface(ctx, "vm7")
+		Expect(errors.IsStatusErrorCode(err, errors.NOT_FOUND)).To(BeTrue())
+		_, err = AttachVM(ctx, c, newSpec())
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
@@ -0,0 +1,402 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/errors"
+)
+
+// EventType is the type of a watch Event.
+type EventType string
+
+const (
+	EventAdded    EventType = "Added"
+	EventModified EventType = "Modified"
+	EventDeleted  EventType = "Deleted"
+)
+
+// Event is emitted by a Watcher when an object appeared, changed or disappeared.
+type Event struct {
+	Type EventType
+	// Object is the current object, or the last observed one for EventDeleted.
+	Object api.Object
+}
+
+// DefaultWatchBuffer is the number of events buffered by a Watcher if WithWatchBuffer is not set.
+const DefaultWatchBuffer = 100
+
+type watchOptions struct {
+	vnis          []uint32
+	loadBalancers []string
+	resync        time.Duration
+	errorHandler  func(error)
+	buffer        int
+}
+
+// WatchOption configures Watch.
+type WatchOption func(*watchOptions)
+
+// WithWatchVNIs sets the VNIs whose routes are watched. By default the VNIs of all interfaces are used.
+func WithWatchVNIs(vnis ...uint32) WatchOption {
+	return func(o *watchOptions) {
+		o.vnis = append(o.vnis, vnis...)
+	}
+}
+
+// WithWatchLoadBalancers sets the load balancers whose targets are watched.
+// dp-service cannot list load balancers, so watching targets requires it.
+func WithWatchLoadBalancers(ids ...string) WatchOption {
+	return func(o *watchOptions) {
+		o.loadBalancers = append(o.loadBalancers, ids...)
+	}
+}
+
+// WithResync emits an EventModified for every cached object each period, even if it did not change.
+func WithResync(period time.Duration) WatchOption {
+	return func(o *watchOptions) {
+		o.resync = period
+	}
+}
+
+// WithWatchErrorHandler is called when polling dp-service fails. Polling continues with the next interval
+// and the cache keeps the last observed objects.
+func WithWatchErrorHandler(handler func(error)) WatchOption {
+	return func(o *watchOptions) {
+		o.errorHandler = handler
+	}
+}
+
+// WithWatchBuffer sets the number of events buffered until polling waits for them to be received.
+func WithWatchBuffer(size int) WatchOption {
+	return func(o *watchOptions) {
+		o.buffer = size
+	}
+}
+
+// Watcher polls dp-service for objects of one kind and keeps them in a local cache.
+type Watcher struct {
+	kind   string
+	events chan Event
+	synced chan struct{}
+
+	mu    sync.RWMutex
+	cache map[string]api.Object
+}
+
+// listFunc lists the objects of a kind, keyed by WatchKey.
+type listFunc func(ctx context.Context, c Client, o *watchOptions) (map[string]api.Object, error)
+
+var watchListFuncs = map[string]listFunc{
+	api.InterfaceKind:          listInterfacesForWatch,
+	api.RouteKind:              listRoutesForWatch,
+	api.NatKind:                listNatsForWatch,
+	api.FirewallRuleKind:       listFirewallRulesForWatch,
+	api.LoadBalancerTargetKind: listLoadBalancerTargetsForWatch,
+}
+
+// Watch lists objects of kind every interval and emits an Event for every added, modified and
+// deleted object, the first list emits EventAdded for every object. Supported kinds are
+// api.InterfaceKind, api.RouteKind, api.NatKind, api.FirewallRuleKind and api.LoadBalancerTargetKind.
+// The events channel is closed when ctx is done.
+//
+// Every poll of routes without WithWatchVNIs, of NATs and of firewall rules lists the interfaces and then
+// calls dp-service once per VNI or interface, so a node with N interfaces gets N+1 calls per interval and
+// watcher. Watchers do not share these calls, the interval should be chosen accordingly.
+func Watch(ctx context.Context, c Client, kind string, interval time.Duration, opts ...WatchOption) (*Watcher, error) {
+	list, ok := watchListFuncs[kind]
+	if !ok {
+		return nil, fmt.Errorf("cannot watch objects of kind %s", kind)
+	}
+	o := &watchOptions{buffer: DefaultWatchBuffer}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.buffer < 0 {
+		o.buffer = 0
+	}
+
+	w := &Watcher{
+		kind:   kind,
+		events: make(chan Event, o.buffer),
+		synced: make(chan struct{}),
+		cache:  map[string]api.Object{},
+	}
+	go w.run(ctx, c, interval, list, o)
+	return w, nil
+}
+
+func (w *Watcher) run(ctx context.Context, c Client, interval time.Duration, list listFunc, o *watchOptions) {
+	defer close(w.events)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var resync <-chan time.Time
+	if o.resync > 0 {
+		resyncTicker := time.NewTicker(o.resync)
+		defer resyncTicker.Stop()
+		resync = resyncTicker.C
+	}
+
+	for {
+		objs, err := list(ctx, c, o)
+		if err != nil {
+			if o.errorHandler != nil && ctx.Err() == nil {
+				o.errorHandler(fmt.Errorf("error listing %s objects: %w", w.kind, err))
+			}
+		} else if !w.send(ctx, w.update(objs)) {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-resync:
+			if !w.send(ctx, w.resyncEvents()) {
+				return
+			}
+		}
+	}
+}
+
+// update replaces the cache with objs and returns the events for the differences.
+func (w *Watcher) update(objs map[string]api.Object) []Event {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var events []Event
+	for _, key := range sortedKeys(objs) {
+		obj := objs[key]
+		old, ok := w.cache[key]
+		switch {
+		case !ok:
+			events = append(events, Event{Type: EventAdded, Object: obj})
+		case !objectEqual(old, obj):
+			events = append(events, Event{Type: EventModified, Object: obj})
+		}
+	}
+	for _, key := range sortedKeys(w.cache) {
+		if _, ok := objs[key]; !ok {
+			events = append(events, Event{Type: EventDeleted, Object: w.cache[key]})
+		}
+	}
+	w.cache = objs
+
+	select {
+	case <-w.synced:
+	default:
+		close(w.synced)
+	}
+	return events
+}
+
+func (w *Watcher) resyncEvents() []Event {
+	objs := w.List()
+	events := make([]Event, len(objs))
+	for i, obj := range objs {
+		events[i] = Event{Type: EventModified, Object: obj}
+	}
+	return events
+}
+
+func (w *Watcher) send(ctx context.Context, events []Event) bool {
+	for _, event := range events {
+		select {
+		case w.events <- event:
+		case <-ctx.Done():
+			return false
+		}
+	}
+	return true
+}
+
+// Events returns the channel of events. It has to be drained, polling waits when the buffer is full.
+func (w *Watcher) Events() <-chan Event {
+	return w.events
+}
+
+// HasSynced reports whether the cache was filled by a successful list.
+func (w *Watcher) HasSynced() bool {
+	select {
+	case <-w.synced:
+		return true
+	default:
+		return false
+	}
+}
+
+// WaitForSync blocks until the cache was filled or ctx is done and reports whether it was filled.
+func (w *Watcher) WaitForSync(ctx context.Context) bool {
+	select {
+	case <-w.synced:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// List returns the cached objects sorted by key.
+func (w *Watcher) List() []api.Object {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	objs := make([]api.Object, 0, len(w.cache))
+	for _, key := range sortedKeys(w.cache) {
+		objs = append(objs, w.cache[key])
+	}
+	return objs
+}
+
+// Get returns the cached object with key, see WatchKey.
+func (w *Watcher) Get(key string) (api.Object, bool) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	obj, ok := w.cache[key]
+	return obj, ok
+}
+
+// WatchKey returns the key of obj in the cache of a Watcher: the interface ID for
+// interfaces and NATs, vni/prefix for routes, interface/rule ID for firewall rules
+// and loadbalancer/target IP for load balancer targets.
+func WatchKey(obj api.Object) string {
+	switch obj := obj.(type) {
+	case *api.Interface:
+		return obj.ID
+	case *api.Nat:
+		return obj.InterfaceID
+	case *api.Route:
+		return fmt.Sprintf("%d/%s", obj.VNI, obj.Spec.Prefix)
+	case *api.FirewallRule:
+		return obj.InterfaceID + "/" + obj.Spec.RuleID
+	case *api.LoadBalancerTarget:
+		return fmt.Sprintf("%s/%s", obj.LoadbalancerID, obj.Spec.TargetIP)
+	default:
+		return obj.GetName()
+	}
+}
+
+func sortedKeys(objs map[string]api.Object) []string {
+	keys := make([]string, 0, len(objs))
+	for key := range objs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// objectEqual compares the JSON encoding, which avoids comparing the internal state of proto messages.
+func objectEqual(a, b api.Object) bool {
+	x, errX := json.Marshal(a)
+	y, errY := json.Marshal(b)
+	return errX == nil && errY == nil && bytes.Equal(x, y)
+}
+
+func addWatched(objs map[string]api.Object, obj api.Object) {
+	objs[WatchKey(obj)] = obj
+}
+
+func listInterfacesForWatch(ctx context.Context, c Client, _ *watchOptions) (map[string]api.Object, error) {
+	ifaces, err := checkList(c.ListInterfaces(ctx))
+	if err != nil {
+		return nil, err
+	}
+	objs := map[string]api.Object{}
+	for i := range ifaces.Items {
+		addWatched(objs, &ifaces.Items[i])
+	}
+	return objs, nil
+}
+
+func listRoutesForWatch(ctx context.Context, c Client, o *watchOptions) (map[string]api.Object, error) {
+	vnis := o.vnis
+	if len(vnis) == 0 {
+		ifaces, err := checkList(c.ListInterfaces(ctx))
+		if err != nil {
+			return nil, err
+		}
+		seen := map[uint32]bool{}
+		for _, iface := range ifaces.Items {
+			if !seen[iface.Spec.VNI] {
+				seen[iface.Spec.VNI] = true
+				vnis = append(vnis, iface.Spec.VNI)
+			}
+		}
+	}
+
+	objs := map[string]api.Object{}
+	for _, vni := range vnis {
+		routes, err := c.ListRoutes(ctx, vni)
+		// dp-service reports NO_VNI for a VNI it holds nothing for, which has no routes.
+		if err == nil && routes.Status.Code == errors.NO_VNI {
+			continue
+		}
+		routes, err = checkList(routes, err)
+		if err != nil {
+			return nil, err
+		}
+		for i := range routes.Items {
+			addWatched(objs, &routes.Items[i])
+		}
+	}
+	return objs, nil
+}
+
+func listNatsForWatch(ctx context.Context, c Client, _ *watchOptions) (map[string]api.Object, error) {
+	ifaces, err := checkList(c.ListInterfaces(ctx))
+	if err != nil {
+		return nil, err
+	}
+	objs := map[string]api.Object{}
+	for _, iface := range ifaces.Items {
+		nat, err := c.GetNat(ctx, iface.ID, IgnoreErrors(errors.SNAT_NO_DATA, errors.NO_VM))
+		if err != nil {
+			return nil, err
+		}
+		if nat.Status.Code == 0 {
+			addWatched(objs, nat)
+		}
+	}
+	return objs, nil
+}
+
+func listFirewallRulesForWatch(ctx context.Context, c Client, _ *watchOptions) (map[string]api.Object, error) {
+	ifaces, err := checkList(c.ListInterfaces(ctx))
+	if err != nil {
+		return nil, err
+	}
+	objs := map[string]api.Object{}
+	for _, iface := range ifaces.Items {
+		rules, err := checkList(c.ListFirewallRules(ctx, iface.ID))
+		if err != nil {
+			return nil, err
+		}
+		for i := range rules.Items {
+			addWatched(objs, &rules.Items[i])
+		}
+	}
+	return objs, nil
+}
+
+func listLoadBalancerTargetsForWatch(ctx context.Context, c Client, o *watchOptions) (map[string]api.Object, error) {
+	objs := map[string]api.Object{}
+	for _, id := range o.loadBalancers {
+		targets, err := checkList(c.ListLoadBalancerTargets(ctx, id))
+		if err != nil {
+			return nil, err
+		}
+		for i := range targets.Items {
+			addWatched(objs, &targets.Items[i])
+		}
+	}
+	return objs, nil
+}
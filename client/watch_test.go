@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sync/atomic"
+	"time"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/errors"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gstruct"
+)
+
+// failingListClient lists interfaces with a non-zero status while fail is set.
+type failingListClient struct {
+	Client
+	fail *atomic.Bool
+}
+
+func (c failingListClient) ListInterfaces(ctx context.Context, opts ...CallOption) (*api.InterfaceList, error) {
+	if c.fail.Load() {
+		return &api.InterfaceList{Status: api.Status{Code: errors.NOT_FOUND, Message: "listing failed"}}, nil
+	}
+	return c.Client.ListInterfaces(ctx, opts...)
+}
+
+var _ = Describe("watch", Label("watch"), func() {
+	var c Client
+
+	BeforeEach(func(ctx SpecContext) {
+		c = newFakeClient(ctx)
+
+		_, err := c.CreateInterface(ctx, &api.Interface{
+			InterfaceMeta: api.InterfaceMeta{ID: "vm1"},
+			Spec: api.InterfaceSpec{
+				VNI:    100,
+				Device: "net_tap2",
+				IPv4:   ptrAddr("10.200.1.4"),
+				IPv6:   ptrAddr("2000:200:1::4"),
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	event := func(eventType EventType, key string) gstruct.Fields {
+		return gstruct.Fields{
+			"Type":   Equal(eventType),
+			"Object": WithTransform(WatchKey, Equal(key)),
+		}
+	}
+
+	It("should emit events for added, modified and deleted NATs", func(ctx SpecContext) {
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		w, err := Watch(watchCtx, c, api.NatKind, 10*time.Millisecond)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(w.WaitForSync(ctx)).To(BeTrue())
+		Expect(w.List()).To(BeEmpty())
+
+		nat := &api.Nat{
+			NatMeta: api.NatMeta{InterfaceID: "vm1"},
+			Spec:    api.NatSpec{NatIP: ptrAddr("20.10.0.1"), MinPort: 1024, MaxPort: 2048},
+		}
+		_, err = c.CreateNat(ctx, nat)
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(w.Events()).Should(Receive(gstruct.MatchAllFields(event(EventAdded, "vm1"))))
+		cached, ok := w.Get("vm1")
+		Expect(ok).To(BeTrue())
+		Expect(cached.(*api.Nat).Spec.MaxPort).To(Equal(uint32(2048)))
+
+		_, err = c.DeleteNat(ctx, "vm1")
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(w.Events()).Should(Receive(gstruct.MatchAllFields(event(EventDeleted, "vm1"))))
+		Expect(w.List()).To(BeEmpty())
+
+		cancel()
+		Eventually(w.Events()).Should(BeClosed())
+	})
+
+	It("should detect modified objects", func() {
+		w := &Watcher{synced: make(chan struct{}), cache: map[string]api.Object{}}
+		nat := &api.Nat{
+			NatMeta: api.NatMeta{InterfaceID: "vm1"},
+			Spec:    api.NatSpec{NatIP: ptrAddr("20.10.0.1"), MinPort: 1024, MaxPort: 2048},
+		}
+		Expect(w.update(map[string]api.Object{"vm1": nat})).To(ConsistOf(gstruct.MatchAllFields(event(EventAdded, "vm1"))))
+		Expect(w.HasSynced()).To(BeTrue())
+
+		same := *nat
+		Expect(w.update(map[string]api.Object{"vm1": &same})).To(BeEmpty())
+
+		modified := *nat
+		modified.Spec.MinPort, modified.Spec.MaxPort = 2048, 4096
+		Expect(w.update(map[string]api.Object{"vm1": &modified})).To(ConsistOf(gstruct.MatchAllFields(event(EventModified, "vm1"))))
+	})
+
+	It("should watch the routes of the interface VNIs and resync", func(ctx SpecContext) {
+		prefix := netip.MustParsePrefix("10.100.3.0/24")
+		_, err := c.CreateRoute(ctx, &api.Route{
+			RouteMeta: api.RouteMeta{VNI: 100},
+			Spec: api.RouteSpec{
+				Prefix:  &prefix,
+				NextHop: &api.RouteNextHop{IP: ptrAddr("fc00:2::64:0:1")},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		w, err := Watch(ctx, c, api.RouteKind, time.Hour, WithResync(10*time.Millisecond))
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(w.Events()).Should(Receive(gstruct.MatchAllFields(event(EventAdded, "100/10.100.3.0/24"))))
+		Eventually(w.Events()).Should(Receive(gstruct.MatchAllFields(event(EventModified, "100/10.100.3.0/24"))))
+		Expect(w.List()).To(HaveLen(1))
+	})
+
+	It("should buffer events until they are received", func(ctx SpecContext) {
+		for i := 3; i <= 5; i++ {
+			prefix := netip.MustParsePrefix(fmt.Sprintf("10.100.%d.0/24", i))
+			_, err := c.CreateRoute(ctx, &api.Route{
+				RouteMeta: api.RouteMeta{VNI: 100},
+				Spec: api.RouteSpec{
+					Prefix:  &prefix,
+					NextHop: &api.RouteNextHop{IP: ptrAddr("fc00:2::64:0:1")},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		w, err := Watch(ctx, c, api.RouteKind, time.Hour, WithWatchBuffer(2))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(w.WaitForSync(ctx)).To(BeTrue())
+		Eventually(func() int { return len(w.Events()) }).Should(Equal(2))
+		Consistently(func() int { return len(w.Events()) }, 50*time.Millisecond).Should(Equal(2))
+		Eventually(w.Events()).Should(Receive(gstruct.MatchAllFields(event(EventAdded, "100/10.100.3.0/24"))))
+	})
+
+	It("should keep the cache when a list fails", func(ctx SpecContext) {
+		fail := &atomic.Bool{}
+		errs := make(chan error, 10)
+		w, err := Watch(ctx, failingListClient{Client: c, fail: fail}, api.InterfaceKind, 10*time.Millisecond,
+			WithWatchErrorHandler(func(err error) {
+				select {
+				case errs <- err:
+				default:
+				}
+			}))
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(w.Events()).Should(Receive(gstruct.MatchAllFields(event(EventAdded, "vm1"))))
+
+		fail.Store(true)
+		Eventually(errs).Should(Receive(MatchError(ContainSubstring("error listing Interface objects"))))
+		Consistently(w.Events(), 50*time.Millisecond).ShouldNot(Receive())
+		Expect(w.List()).To(HaveLen(1))
+
+		fail.Store(false)
+		Consistently(w.Events(), 50*time.Millisecond).ShouldNot(Receive())
+	})
+
+	It("should reject unsupported kinds", func(ctx SpecContext) {
+		_, err := Watch(ctx, c, api.VersionKind, time.Second)
+		Expect(err).To(MatchError("cannot watch objects of kind Version"))
+	})
+})
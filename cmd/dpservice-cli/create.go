@@ -0,0 +1,444 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/client"
+	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
+	"github.com/spf13/cobra"
+)
+
+func newCreateCommand(o *rootOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a resource",
+	}
+
+	cmd.AddCommand(
+		newCreateInterfaceCommand(o),
+		newCreatePrefixCommand(o),
+		newCreateLoadBalancerPrefixCommand(o),
+		newCreateVirtualIPCommand(o),
+		newCreateLoadBalancerCommand(o),
+		newCreateLoadBalancerTargetCommand(o),
+		newCreateNatCommand(o),
+		newCreateNeighborNatCommand(o),
+		newCreateRouteCommand(o),
+		newCreateFirewallRuleCommand(o),
+		newCreateCaptureCommand(o),
+	)
+	return cmd
+}
+
+func newCreateInterfaceCommand(o *rootOptions) *cobra.Command {
+	var (
+		vni                   uint32
+		ifaceType             string
+		device, ipv4, ipv6    string
+		pxeServer, pxeFile    string
+		totalRate, publicRate uint64
+	)
+	cmd := &cobra.Command{
+		Use:   "interface ID",
+		Short: "Create an interface",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ipv4Addr, err := parseRequiredAddr("--ipv4", ipv4)
+			if err != nil {
+				return err
+			}
+			ipv6Addr, err := parseRequiredAddr("--ipv6", ipv6)
+			if err != nil {
+				return err
+			}
+			iface := &api.Interface{
+				TypeMeta:      api.TypeMeta{Kind: api.InterfaceKind},
+				InterfaceMeta: api.InterfaceMeta{ID: args[0]},
+				Spec: api.InterfaceSpec{
+					Type:     ifaceType,
+					VNI:      vni,
+					Device:   device,
+					IPv4:     ipv4Addr,
+					IPv6:     ipv6Addr,
+					Metering: &api.MeteringParams{TotalRate: totalRate, PublicRate: publicRate},
+				},
+			}
+			if pxeServer != "" || pxeFile != "" {
+				iface.Spec.PXE = &api.PXE{Server: pxeServer, FileName: pxeFile}
+			}
+			return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+				return c.CreateInterface(ctx, iface)
+			})
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVar(&ifaceType, "type", api.InterfaceTypeVirtual, "interface type, virtual or baremetal")
+	flags.Uint32Var(&vni, "vni", 0, "VNI of the interface")
+	flags.StringVar(&device, "device", "", "device name, e.g. net_tap2 or a PCI address")
+	flags.StringVar(&ipv4, "ipv4", "", "primary IPv4 address")
+	flags.StringVar(&ipv6, "ipv6", "", "primary IPv6 address")
+	flags.StringVar(&pxeServer, "pxe-server", "", "PXE next server")
+	flags.StringVar(&pxeFile, "pxe-file", "", "PXE boot file name")
+	flags.Uint64Var(&totalRate, "total-rate", 0, "total metering rate in Mbps")
+	flags.Uint64Var(&publicRate, "public-rate", 0, "public metering rate in Mbps")
+	return cmd
+}
+
+func newCreatePrefixCommand(o *rootOptions) *cobra.Command {
+	var interfaceID, prefix string
+	cmd := &cobra.Command{
+		Use:   "prefix",
+		Short: "Create a prefix routed to an interface",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			p, err := parseRequiredPrefix("--prefix", prefix)
+			if err != nil {
+				return err
+			}
+			return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+				return c.CreatePrefix(ctx, &api.Prefix{
+					TypeMeta:   api.TypeMeta{Kind: api.PrefixKind},
+					PrefixMeta: api.PrefixMeta{InterfaceID: interfaceID},
+					Spec:       api.PrefixSpec{Prefix: *p},
+				})
+			})
+		},
+	}
+	cmd.Flags().StringVar(&interfaceID, "interface-id", "", "interface the prefix is routed to")
+	cmd.Flags().StringVar(&prefix, "prefix", "", "prefix, e.g. 10.20.30.0/24")
+	return cmd
+}
+
+func newCreateLoadBalancerPrefixCommand(o *rootOptions) *cobra.Command {
+	var interfaceID, prefix string
+	cmd := &cobra.Command{
+		Use:   "lbprefix",
+		Short: "Create a load balancer prefix on an interface",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			p, err := parseRequiredPrefix("--prefix", prefix)
+			if err != nil {
+				return err
+			}
+			return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+				return c.CreateLoadBalancerPrefix(ctx, &api.LoadBalancerPrefix{
+					TypeMeta:               api.TypeMeta{Kind: api.LoadBalancerPrefixKind},
+					LoadBalancerPrefixMeta: api.LoadBalancerPrefixMeta{InterfaceID: interfaceID},
+					Spec:                   api.LoadBalancerPrefixSpec{Prefix: *p},
+				})
+			})
+		},
+	}
+	cmd.Flags().StringVar(&interfaceID, "interface-id", "", "interface of the load balancer prefix")
+	cmd.Flags().StringVar(&prefix, "prefix", "", "prefix, e.g. 10.20.30.0/32")
+	return cmd
+}
+
+func newCreateVirtualIPCommand(o *rootOptions) *cobra.Command {
+	var interfaceID, vip string
+	cmd := &cobra.Command{
+		Use:   "virtualip",
+		Short: "Create the virtual IP of an interface",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ip, err := parseRequiredAddr("--vip", vip)
+			if err != nil {
+				return err
+			}
+			return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+				return c.CreateVirtualIP(ctx, &api.VirtualIP{
+					TypeMeta:      api.TypeMeta{Kind: api.VirtualIPKind},
+					VirtualIPMeta: api.VirtualIPMeta{InterfaceID: interfaceID},
+					Spec:          api.VirtualIPSpec{IP: ip},
+				})
+			})
+		},
+	}
+	cmd.Flags().StringVar(&interfaceID, "interface-id", "", "interface of the virtual IP")
+	cmd.Flags().StringVar(&vip, "vip", "", "virtual IP address")
+	return cmd
+}
+
+func newCreateLoadBalancerCommand(o *rootOptions) *cobra.Command {
+	var (
+		vni     uint32
+		vip     string
+		lbports []string
+	)
+	cmd := &cobra.Command{
+		Use:   "loadbalancer ID",
+		Short: "Create a load balancer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ip, err := parseRequiredAddr("--vip", vip)
+			if err != nil {
+				return err
+			}
+			ports, err := parseLbports("--lbports", lbports)
+			if err != nil {
+				return err
+			}
+			return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+				return c.CreateLoadBalancer(ctx, &api.LoadBalancer{
+					TypeMeta:         api.TypeMeta{Kind: api.LoadBalancerKind},
+					LoadBalancerMeta: api.LoadBalancerMeta{ID: args[0]},
+					Spec:             api.LoadBalancerSpec{VNI: vni, LbVipIP: ip, Lbports: ports},
+				})
+			})
+		},
+	}
+	cmd.Flags().Uint32Var(&vni, "vni", 0, "VNI of the load balancer")
+	cmd.Flags().StringVar(&vip, "vip", "", "load balanced IP address")
+	cmd.Flags().StringSliceVar(&lbports, "lbports", nil, "load balanced ports, e.g. tcp/80,udp/53")
+	return cmd
+}
+
+func newCreateLoadBalancerTargetCommand(o *rootOptions) *cobra.Command {
+	var lbID, targetIP string
+	cmd := &cobra.Command{
+		Use:   "lbtarget",
+		Short: "Add a target to a load balancer",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ip, err := parseRequiredAddr("--target-ip", targetIP)
+			if err != nil {
+				return err
+			}
+			return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+				return c.CreateLoadBalancerTarget(ctx, &api.LoadBalancerTarget{
+					TypeMeta:               api.TypeMeta{Kind: api.LoadBalancerTargetKind},
+					LoadBalancerTargetMeta: api.LoadBalancerTargetMeta{LoadbalancerID: lbID},
+					Spec:                   api.LoadBalancerTargetSpec{TargetIP: ip},
+				})
+			})
+		},
+	}
+	cmd.Flags().StringVar(&lbID, "lb-id", "", "load balancer of the target")
+	cmd.Flags().StringVar(&targetIP, "target-ip", "", "underlay IPv6 address of the target")
+	return cmd
+}
+
+func newCreateNatCommand(o *rootOptions) *cobra.Command {
+	var (
+		interfaceID, natIP string
+		minPort, maxPort   uint32
+	)
+	cmd := &cobra.Command{
+		Use:   "nat",
+		Short: "Create the NAT of an interface",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ip, err := parseRequiredAddr("--nat-ip", natIP)
+			if err != nil {
+				return err
+			}
+			return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+				return c.CreateNat(ctx, &api.Nat{
+					TypeMeta: api.TypeMeta{Kind: api.NatKind},
+					NatMeta:  api.NatMeta{InterfaceID: interfaceID},
+					Spec:     api.NatSpec{NatIP: ip, MinPort: minPort, MaxPort: maxPort},
+				})
+			})
+		},
+	}
+	cmd.Flags().StringVar(&interfaceID, "interface-id", "", "interface of the NAT")
+	cmd.Flags().StringVar(&natIP, "nat-ip", "", "NAT IP address")
+	cmd.Flags().Uint32Var(&minPort, "min-port", 0, "first port of the NAT range")
+	cmd.Flags().Uint32Var(&maxPort, "max-port", 0, "end of the NAT range (exclusive)")
+	return cmd
+}
+
+func newCreateNeighborNatCommand(o *rootOptions) *cobra.Command {
+	var (
+		natIP, underlayRoute  string
+		vni, minPort, maxPort uint32
+	)
+	cmd := &cobra.Command{
+		Use:   "neighbornat",
+		Short: "Create a NAT range handled by another node",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ip, err := parseRequiredAddr("--nat-ip", natIP)
+			if err != nil {
+				return err
+			}
+			underlay, err := parseRequiredAddr("--underlay-route", underlayRoute)
+			if err != nil {
+				return err
+			}
+			return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+				return c.CreateNeighborNat(ctx, &api.NeighborNat{
+					TypeMeta:        api.TypeMeta{Kind: api.NeighborNatKind},
+					NeighborNatMeta: api.NeighborNatMeta{NatIP: ip},
+					Spec:            api.NeighborNatSpec{Vni: vni, MinPort: minPort, MaxPort: maxPort, UnderlayRoute: underlay},
+				})
+			})
+		},
+	}
+	cmd.Flags().StringVar(&natIP, "nat-ip", "", "NAT IP address")
+	cmd.Flags().Uint32Var(&vni, "vni", 0, "VNI of the neighbor NAT")
+	cmd.Flags().Uint32Var(&minPort, "min-port", 0, "first port of the NAT range")
+	cmd.Flags().Uint32Var(&maxPort, "max-port", 0, "end of the NAT range (exclusive)")
+	cmd.Flags().StringVar(&underlayRoute, "underlay-route", "", "underlay address of the node handling the range")
+	return cmd
+}
+
+func newCreateRouteCommand(o *rootOptions) *cobra.Command {
+	var (
+		vni, nextHopVNI   uint32
+		prefix, nextHopIP string
+	)
+	cmd := &cobra.Command{
+		Use:   "route",
+		Short: "Create a route in a VNI",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			p, err := parseRequiredPrefix("--prefix", prefix)
+			if err != nil {
+				return err
+			}
+			ip, err := parseRequiredAddr("--next-hop-ip", nextHopIP)
+			if err != nil {
+				return err
+			}
+			return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+				return c.CreateRoute(ctx, &api.Route{
+					TypeMeta:  api.TypeMeta{Kind: api.RouteKind},
+					RouteMeta: api.RouteMeta{VNI: vni},
+					Spec:      api.RouteSpec{Prefix: p, NextHop: &api.RouteNextHop{VNI: nextHopVNI, IP: ip}},
+				})
+			})
+		},
+	}
+	cmd.Flags().Uint32Var(&vni, "vni", 0, "VNI of the route")
+	cmd.Flags().StringVar(&prefix, "prefix", "", "destination prefix")
+	cmd.Flags().Uint32Var(&nextHopVNI, "next-hop-vni", 0, "VNI of the next hop")
+	cmd.Flags().StringVar(&nextHopIP, "next-hop-ip", "", "underlay address of the next hop")
+	return cmd
+}
+
+func newCreateFirewallRuleCommand(o *rootOptions) *cobra.Command {
+	var (
+		interfaceID, direction, action string
+		priority                       uint32
+		src, dst, protocol             string
+		srcPortMin, srcPortMax         int32
+		dstPortMin, dstPortMax         int32
+		icmpType, icmpCode             int32
+	)
+	cmd := &cobra.Command{
+		Use:   "firewallrule RULE_ID",
+		Short: "Create a firewall rule on an interface",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			srcPrefix, err := parseRequiredPrefix("--src", src)
+			if err != nil {
+				return err
+			}
+			dstPrefix, err := parseRequiredPrefix("--dst", dst)
+			if err != nil {
+				return err
+			}
+
+			var filter *dpdkproto.ProtocolFilter
+			switch strings.ToLower(protocol) {
+			case "":
+			case "tcp":
+				filter = &dpdkproto.ProtocolFilter{Filter: &dpdkproto.ProtocolFilter_Tcp{Tcp: &dpdkproto.TcpFilter{
+					SrcPortLower: srcPortMin, SrcPortUpper: srcPortMax,
+					DstPortLower: dstPortMin, DstPortUpper: dstPortMax,
+				}}}
+			case "udp":
+				filter = &dpdkproto.ProtocolFilter{Filter: &dpdkproto.ProtocolFilter_Udp{Udp: &dpdkproto.UdpFilter{
+					SrcPortLower: srcPortMin, SrcPortUpper: srcPortMax,
+					DstPortLower: dstPortMin, DstPortUpper: dstPortMax,
+				}}}
+			case "icmp":
+				filter = &dpdkproto.ProtocolFilter{Filter: &dpdkproto.ProtocolFilter_Icmp{Icmp: &dpdkproto.IcmpFilter{
+					IcmpType: icmpType, IcmpCode: icmpCode,
+				}}}
+			default:
+				return fmt.Errorf("unsupported protocol %q, one of tcp|udp|icmp", protocol)
+			}
+
+			return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+				return c.CreateFirewallRule(ctx, &api.FirewallRule{
+					TypeMeta:         api.TypeMeta{Kind: api.FirewallRuleKind},
+					FirewallRuleMeta: api.FirewallRuleMeta{InterfaceID: interfaceID},
+					Spec: api.FirewallRuleSpec{
+						RuleID:            args[0],
+						TrafficDirection:  direction,
+						FirewallAction:    action,
+						Priority:          priority,
+						SourcePrefix:      srcPrefix,
+						DestinationPrefix: dstPrefix,
+						ProtocolFilter:    filter,
+					},
+				})
+			})
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVar(&interfaceID, "interface-id", "", "interface of the firewall rule")
+	flags.StringVar(&direction, "direction", "ingress", "traffic direction, one of ingress|egress")
+	flags.StringVar(&action, "action", "accept", "firewall action, one of accept|drop")
+	flags.Uint32Var(&priority, "priority", 1000, "priority of the rule")
+	flags.StringVar(&src, "src", "0.0.0.0/0", "source prefix")
+	flags.StringVar(&dst, "dst", "0.0.0.0/0", "destination prefix")
+	flags.StringVar(&protocol, "protocol", "", "protocol to filter, one of tcp|udp|icmp, empty matches all")
+	flags.Int32Var(&srcPortMin, "src-port-min", -1, "lowest source port, -1 matches all")
+	flags.Int32Var(&srcPortMax, "src-port-max", -1, "highest source port, -1 matches all")
+	flags.Int32Var(&dstPortMin, "dst-port-min", -1, "lowest destination port, -1 matches all")
+	flags.Int32Var(&dstPortMax, "dst-port-max", -1, "highest destination port, -1 matches all")
+	flags.Int32Var(&icmpType, "icmp-type", -1, "ICMP type, -1 matches all")
+	flags.Int32Var(&icmpCode, "icmp-code", -1, "ICMP code, -1 matches all")
+	return cmd
+}
+
+func newCreateCaptureCommand(o *rootOptions) *cobra.Command {
+	var (
+		sinkNodeIP             string
+		udpSrcPort, udpDstPort uint32
+		pfs, vfs               []string
+	)
+	cmd := &cobra.Command{
+		Use:   "capture",
+		Short: "Start capturing packets of PFs and VFs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sink, err := parseRequiredAddr("--sink-node-ip", sinkNodeIP)
+			if err != nil {
+				return err
+			}
+			capture := &api.CaptureStart{
+				TypeMeta: api.TypeMeta{Kind: api.CaptureStartKind},
+				CaptureStartMeta: api.CaptureStartMeta{Config: &api.CaptureConfig{
+					SinkNodeIP: sink,
+					UdpSrcPort: udpSrcPort,
+					UdpDstPort: udpDstPort,
+				}},
+			}
+			for _, pf := range pfs {
+				capture.Spec.Interfaces = append(capture.Spec.Interfaces, api.CaptureInterface{InterfaceType: "pf", InterfaceInfo: pf})
+			}
+			for _, vf := range vfs {
+				capture.Spec.Interfaces = append(capture.Spec.Interfaces, api.CaptureInterface{InterfaceType: "vf", InterfaceInfo: vf})
+			}
+			return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+				return c.CaptureStart(ctx, capture)
+			})
+		},
+	}
+	cmd.Flags().StringVar(&sinkNodeIP, "sink-node-ip", "", "underlay address the captured packets are sent to")
+	cmd.Flags().Uint32Var(&udpSrcPort, "udp-src-port", 3000, "UDP source port of the captured packets")
+	cmd.Flags().Uint32Var(&udpDstPort, "udp-dst-port", 3010, "UDP destination port of the captured packets")
+	cmd.Flags().StringSliceVar(&pfs, "pf", nil, "indexes of PFs to capture")
+	cmd.Flags().StringSliceVar(&vfs, "vf", nil, "names of VFs to capture")
+	return cmd
+}
@@ -0,0 +1,226 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/client"
+	"github.com/spf13/cobra"
+)
+
+func newDeleteCommand(o *rootOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a resource",
+	}
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "interface ID",
+			Short: "Delete an interface",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+					return c.DeleteInterface(ctx, args[0])
+				})
+			},
+		},
+		newDeletePrefixCommand(o),
+		newDeleteLoadBalancerPrefixCommand(o),
+		&cobra.Command{
+			Use:   "virtualip INTERFACE_ID",
+			Short: "Delete the virtual IP of an interface",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+					return c.DeleteVirtualIP(ctx, args[0])
+				})
+			},
+		},
+		&cobra.Command{
+			Use:   "loadbalancer ID",
+			Short: "Delete a load balancer",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+					return c.DeleteLoadBalancer(ctx, args[0])
+				})
+			},
+		},
+		newDeleteLoadBalancerTargetCommand(o),
+		&cobra.Command{
+			Use:   "nat INTERFACE_ID",
+			Short: "Delete the NAT of an interface",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+					return c.DeleteNat(ctx, args[0])
+				})
+			},
+		},
+		newDeleteNeighborNatCommand(o),
+		newDeleteRouteCommand(o),
+		&cobra.Command{
+			Use:   "firewallrule INTERFACE_ID RULE_ID",
+			Short: "Delete a firewall rule of an interface",
+			Args:  cobra.ExactArgs(2),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+					return c.DeleteFirewallRule(ctx, args[0], args[1])
+				})
+			},
+		},
+		newDeleteVniCommand(o),
+		&cobra.Command{
+			Use:   "capture",
+			Short: "Stop capturing packets",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+					return c.CaptureStop(ctx)
+				})
+			},
+		},
+	)
+	return cmd
+}
+
+func newDeletePrefixCommand(o *rootOptions) *cobra.Command {
+	var interfaceID, prefix string
+	cmd := &cobra.Command{
+		Use:   "prefix",
+		Short: "Delete a prefix of an interface",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			p, err := parseRequiredPrefix("--prefix", prefix)
+			if err != nil {
+				return err
+			}
+			return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+				return c.DeletePrefix(ctx, interfaceID, p)
+			})
+		},
+	}
+	cmd.Flags().StringVar(&interfaceID, "interface-id", "", "interface of the prefix")
+	cmd.Flags().StringVar(&prefix, "prefix", "", "prefix to delete")
+	return cmd
+}
+
+func newDeleteLoadBalancerPrefixCommand(o *rootOptions) *cobra.Command {
+	var interfaceID, prefix string
+	cmd := &cobra.Command{
+		Use:   "lbprefix",
+		Short: "Delete a load balancer prefix of an interface",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			p, err := parseRequiredPrefix("--prefix", prefix)
+			if err != nil {
+				return err
+			}
+			return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+				return c.DeleteLoadBalancerPrefix(ctx, interfaceID, p)
+			})
+		},
+	}
+	cmd.Flags().StringVar(&interfaceID, "interface-id", "", "interface of the load balancer prefix")
+	cmd.Flags().StringVar(&prefix, "prefix", "", "prefix to delete")
+	return cmd
+}
+
+func newDeleteLoadBalancerTargetCommand(o *rootOptions) *cobra.Command {
+	var lbID, targetIP string
+	cmd := &cobra.Command{
+		Use:   "lbtarget",
+		Short: "Remove a target from a load balancer",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ip, err := parseRequiredAddr("--target-ip", targetIP)
+			if err != nil {
+				return err
+			}
+			return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+				return c.DeleteLoadBalancerTarget(ctx, lbID, ip)
+			})
+		},
+	}
+	cmd.Flags().StringVar(&lbID, "lb-id", "", "load balancer of the target")
+	cmd.Flags().StringVar(&targetIP, "target-ip", "", "underlay IPv6 address of the target")
+	return cmd
+}
+
+func newDeleteNeighborNatCommand(o *rootOptions) *cobra.Command {
+	var (
+		natIP                 string
+		vni, minPort, maxPort uint32
+	)
+	cmd := &cobra.Command{
+		Use:   "neighbornat",
+		Short: "Delete a NAT range handled by another node",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ip, err := parseRequiredAddr("--nat-ip", natIP)
+			if err != nil {
+				return err
+			}
+			return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+				return c.DeleteNeighborNat(ctx, &api.NeighborNat{
+					TypeMeta:        api.TypeMeta{Kind: api.NeighborNatKind},
+					NeighborNatMeta: api.NeighborNatMeta{NatIP: ip},
+					Spec:            api.NeighborNatSpec{Vni: vni, MinPort: minPort, MaxPort: maxPort},
+				})
+			})
+		},
+	}
+	cmd.Flags().StringVar(&natIP, "nat-ip", "", "NAT IP address")
+	cmd.Flags().Uint32Var(&vni, "vni", 0, "VNI of the neighbor NAT")
+	cmd.Flags().Uint32Var(&minPort, "min-port", 0, "first port of the NAT range")
+	cmd.Flags().Uint32Var(&maxPort, "max-port", 0, "end of the NAT range (exclusive)")
+	return cmd
+}
+
+func newDeleteRouteCommand(o *rootOptions) *cobra.Command {
+	var (
+		vni    uint32
+		prefix string
+	)
+	cmd := &cobra.Command{
+		Use:   "route",
+		Short: "Delete a route of a VNI",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			p, err := parseRequiredPrefix("--prefix", prefix)
+			if err != nil {
+				return err
+			}
+			return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+				return c.DeleteRoute(ctx, vni, p)
+			})
+		},
+	}
+	cmd.Flags().Uint32Var(&vni, "vni", 0, "VNI of the route")
+	cmd.Flags().StringVar(&prefix, "prefix", "", "destination prefix of the route")
+	return cmd
+}
+
+func newDeleteVniCommand(o *rootOptions) *cobra.Command {
+	var vniType uint8
+	cmd := &cobra.Command{
+		Use:   "vni VNI",
+		Short: "Reset a VNI, deleting all of its routes",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vni, err := parseVNI(args[0])
+			if err != nil {
+				return err
+			}
+			return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+				return c.ResetVni(ctx, vni, vniType)
+			})
+		},
+	}
+	cmd.Flags().Uint8Var(&vniType, "vni-type", 0, "VNI type, 0 for IPv4, 1 for IPv6, 2 for both")
+	return cmd
+}
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+
+	"github.com/ironcore-dev/dpservice-go/client"
+	"github.com/spf13/cobra"
+)
+
+func newGetCommand(o *rootOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Get a single resource",
+	}
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "interface ID",
+			Short: "Get an interface",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+					return c.GetInterface(ctx, args[0])
+				})
+			},
+		},
+		&cobra.Command{
+			Use:   "virtualip INTERFACE_ID",
+			Short: "Get the virtual IP of an interface",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+					return c.GetVirtualIP(ctx, args[0])
+				})
+			},
+		},
+		&cobra.Command{
+			Use:   "loadbalancer ID",
+			Short: "Get a load balancer",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+					return c.GetLoadBalancer(ctx, args[0])
+				})
+			},
+		},
+		&cobra.Command{
+			Use:   "nat INTERFACE_ID",
+			Short: "Get the NAT of an interface",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+					return c.GetNat(ctx, args[0])
+				})
+			},
+		},
+		&cobra.Command{
+			Use:   "firewallrule INTERFACE_ID RULE_ID",
+			Short: "Get a firewall rule of an interface",
+			Args:  cobra.ExactArgs(2),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+					return c.GetFirewallRule(ctx, args[0], args[1])
+				})
+			},
+		},
+		newGetVniCommand(o),
+		&cobra.Command{
+			Use:   "capture",
+			Short: "Get the packet capture status",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+					return c.CaptureStatus(ctx)
+				})
+			},
+		},
+		&cobra.Command{
+			Use:   "init",
+			Short: "Check whether dp-service is initialized",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+					return c.CheckInitialized(ctx)
+				})
+			},
+		},
+	)
+	return cmd
+}
+
+func newGetVniCommand(o *rootOptions) *cobra.Command {
+	var vniType uint8
+	cmd := &cobra.Command{
+		Use:   "vni VNI",
+		Short: "Check whether a VNI is in use",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vni, err := parseVNI(args[0])
+			if err != nil {
+				return err
+			}
+			return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+				return c.GetVni(ctx, vni, vniType)
+			})
+		},
+	}
+	cmd.Flags().Uint8Var(&vniType, "vni-type", 0, "VNI type, 0 for IPv4, 1 for IPv6, 2 for both")
+	return cmd
+}
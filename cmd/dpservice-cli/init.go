@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/client"
+	"github.com/spf13/cobra"
+)
+
+func newInitCommand(o *rootOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "init",
+		Short: "Initialize dp-service",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+				return c.Initialize(ctx)
+			})
+		},
+	}
+}
+
+func newVersionCommand(o *rootOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "version",
+		Short: "Show the protocol and version of dp-service",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+				return c.GetVersion(ctx, &api.Version{
+					TypeMeta:    api.TypeMeta{Kind: api.VersionKind},
+					VersionMeta: api.VersionMeta{ClientName: "dpservice-cli"},
+				})
+			})
+		},
+	}
+}
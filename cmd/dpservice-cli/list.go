@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+
+	"github.com/ironcore-dev/dpservice-go/client"
+	"github.com/spf13/cobra"
+)
+
+func newListCommand(o *rootOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List resources",
+	}
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:     "interfaces",
+			Aliases: []string{"interface"},
+			Short:   "List all interfaces",
+			Args:    cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+					return c.ListInterfaces(ctx)
+				})
+			},
+		},
+		&cobra.Command{
+			Use:     "prefixes INTERFACE_ID",
+			Aliases: []string{"prefix"},
+			Short:   "List the prefixes of an interface",
+			Args:    cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+					return c.ListPrefixes(ctx, args[0])
+				})
+			},
+		},
+		&cobra.Command{
+			Use:     "lbprefixes INTERFACE_ID",
+			Aliases: []string{"lbprefix"},
+			Short:   "List the load balancer prefixes of an interface",
+			Args:    cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+					return c.ListLoadBalancerPrefixes(ctx, args[0])
+				})
+			},
+		},
+		&cobra.Command{
+			Use:     "lbtargets LOADBALANCER_ID",
+			Aliases: []string{"lbtarget"},
+			Short:   "List the targets of a load balancer",
+			Args:    cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+					return c.ListLoadBalancerTargets(ctx, args[0])
+				})
+			},
+		},
+		&cobra.Command{
+			Use:     "routes VNI",
+			Aliases: []string{"route"},
+			Short:   "List the routes of a VNI",
+			Args:    cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				vni, err := parseVNI(args[0])
+				if err != nil {
+					return err
+				}
+				return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+					return c.ListRoutes(ctx, vni)
+				})
+			},
+		},
+		&cobra.Command{
+			Use:     "firewallrules INTERFACE_ID",
+			Aliases: []string{"firewallrule"},
+			Short:   "List the firewall rules of an interface",
+			Args:    cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+					return c.ListFirewallRules(ctx, args[0])
+				})
+			},
+		},
+		newListNatsCommand(o),
+		&cobra.Command{
+			Use:     "neighbornats NAT_IP",
+			Aliases: []string{"neighbornat"},
+			Short:   "List the neighbor NATs of a NAT IP",
+			Args:    cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				natIP, err := parseRequiredAddr("nat ip", args[0])
+				if err != nil {
+					return err
+				}
+				return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+					return c.ListNeighborNats(ctx, natIP)
+				})
+			},
+		},
+	)
+	return cmd
+}
+
+func newListNatsCommand(o *rootOptions) *cobra.Command {
+	var natType string
+	cmd := &cobra.Command{
+		Use:     "nats NAT_IP",
+		Aliases: []string{"nat"},
+		Short:   "List the local and neighbor NATs of a NAT IP",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			natIP, err := parseRequiredAddr("nat ip", args[0])
+			if err != nil {
+				return err
+			}
+			return run(cmd, o, func(ctx context.Context, c client.Client) (any, error) {
+				return c.ListNats(ctx, natIP, natType)
+			})
+		},
+	}
+	cmd.Flags().StringVar(&natType, "nat-type", "any", "NAT type, one of any|local|neighbor")
+	return cmd
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Command dpservice-cli inspects and configures a dp-service node.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ironcore-dev/dpservice-go/client"
+	"github.com/ironcore-dev/dpservice-go/printer"
+	"github.com/spf13/cobra"
+)
+
+type rootOptions struct {
+	address  string
+	timeout  time.Duration
+	caFile   string
+	certFile string
+	keyFile  string
+	output   string
+	sortBy   string
+}
+
+// newClient connects to dp-service, tests replace it to use a fake.
+var newClient = dialClient
+
+func dialClient(ctx context.Context, o *rootOptions) (client.Client, error) {
+	opts := []client.Option{client.WithUserAgent("dpservice-cli")}
+	if o.caFile != "" || o.certFile != "" || o.keyFile != "" {
+		opts = append(opts, client.WithTLSFromFiles(o.caFile, o.certFile, o.keyFile))
+	}
+	return client.Dial(ctx, o.address, opts...)
+}
+
+func newRootCommand() *cobra.Command {
+	o := &rootOptions{}
+
+	cmd := &cobra.Command{
+		Use:           "dpservice-cli",
+		Short:         "Inspect and configure a dp-service node",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	flags := cmd.PersistentFlags()
+	flags.StringVar(&o.address, "address", "localhost:1337", "dp-service address, host:port or path to a unix socket")
+	flags.DurationVar(&o.timeout, "timeout", 5*time.Second, "timeout of the whole command")
+	flags.StringVar(&o.caFile, "ca-file", "", "CA used to verify dp-service, enables TLS")
+	flags.StringVar(&o.certFile, "cert-file", "", "client certificate for mutual TLS")
+	flags.StringVar(&o.keyFile, "key-file", "", "client key for mutual TLS")
+	flags.StringVarP(&o.output, "output", "o", printer.FormatJSON, "output format, one of json|yaml|table|wide|name")
+	flags.StringVar(&o.sortBy, "sort-by", "", "sort table rows by the column with this header")
+
+	cmd.AddCommand(
+		newGetCommand(o),
+		newListCommand(o),
+		newCreateCommand(o),
+		newDeleteCommand(o),
+		newInitCommand(o),
+		newVersionCommand(o),
+	)
+	return cmd
+}
+
+// run connects to dp-service, calls fn and prints the returned object.
+func run(cmd *cobra.Command, o *rootOptions, fn func(ctx context.Context, c client.Client) (any, error)) error {
+	var opts []printer.Option
+	if o.sortBy != "" {
+		opts = append(opts, printer.WithSortBy(o.sortBy))
+	}
+	p, err := printer.New(o.output, opts...)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), o.timeout)
+	defer cancel()
+
+	c, err := newClient(ctx, o)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	obj, err := fn(ctx, c)
+	if err != nil {
+		return err
+	}
+	return p.Print(cmd.OutOrStdout(), obj)
+}
+
+func main() {
+	if err := newRootCommand().Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
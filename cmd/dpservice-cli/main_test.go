@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/client"
+	"github.com/ironcore-dev/dpservice-go/dpservicetest"
+	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("dpservice-cli", func() {
+	var server *dpservicetest.Server
+
+	BeforeEach(func() {
+		server = dpservicetest.NewServer()
+		server.Start()
+		DeferCleanup(server.Close)
+	})
+
+	BeforeEach(func() {
+		dial := newClient
+		DeferCleanup(func() { newClient = dial })
+		newClient = func(ctx context.Context, _ *rootOptions) (client.Client, error) {
+			conn, err := server.Dial(ctx)
+			if err != nil {
+				return nil, err
+			}
+			DeferCleanup(conn.Close)
+			return client.NewClient(dpdkproto.NewDPDKironcoreClient(conn)), nil
+		}
+	})
+
+	execute := func(args ...string) (string, error) {
+		cmd := newRootCommand()
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+		cmd.SetArgs(args)
+		err := cmd.Execute()
+		return out.String(), err
+	}
+
+	It("should create, get, list and delete an interface", func() {
+		_, err := execute("init")
+		Expect(err).NotTo(HaveOccurred())
+
+		out, err := execute("create", "interface", "vm1", "--vni", "100", "--device", "net_tap2",
+			"--ipv4", "10.200.1.4", "--ipv6", "2000:200:1::4")
+		Expect(err).NotTo(HaveOccurred())
+		iface := &api.Interface{}
+		Expect(json.Unmarshal([]byte(out), iface)).To(Succeed())
+		Expect(iface.Kind).To(Equal(api.InterfaceKind))
+		Expect(iface.Spec.UnderlayRoute).NotTo(BeNil())
+
+		out, err = execute("get", "interface", "vm1", "-o", "yaml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring("primary_ipv4: 10.200.1.4"))
+
+		out, err = execute("list", "interfaces", "-o", "table")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(MatchRegexp(`vm1\s+100\s+net_tap2\s+10.200.1.4`))
+
+		out, err = execute("list", "interfaces")
+		Expect(err).NotTo(HaveOccurred())
+		list := &api.InterfaceList{}
+		Expect(json.Unmarshal([]byte(out), list)).To(Succeed())
+		Expect(list.Items).To(HaveLen(1))
+
+		_, err = execute("delete", "interface", "vm1")
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = execute("get", "interface", "vm1")
+		Expect(err).To(MatchError(ContainSubstring("NOT_FOUND")))
+	})
+
+	It("should parse load balancer ports", func() {
+		_, err := execute("init")
+		Expect(err).NotTo(HaveOccurred())
+
+		out, err := execute("create", "loadbalancer", "lb1", "--vni", "100", "--vip", "10.20.30.40", "--lbports", "tcp/443,udp/53")
+		Expect(err).NotTo(HaveOccurred())
+		lb := &api.LoadBalancer{}
+		Expect(json.Unmarshal([]byte(out), lb)).To(Succeed())
+		Expect(lb.Spec.Lbports).To(ConsistOf(api.LBPort{Protocol: 6, Port: 443}, api.LBPort{Protocol: 17, Port: 53}))
+
+		_, err = execute("create", "loadbalancer", "lb2", "--vni", "100", "--vip", "10.20.30.41", "--lbports", "443")
+		Expect(err).To(MatchError(ContainSubstring("error parsing --lbports 443")))
+	})
+})
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+)
+
+func parseAddr(name, value string) (*netip.Addr, error) {
+	if value == "" {
+		return nil, nil
+	}
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", name, err)
+	}
+	return &addr, nil
+}
+
+func parseRequiredAddr(name, value string) (*netip.Addr, error) {
+	if value == "" {
+		return nil, fmt.Errorf("%s is required", name)
+	}
+	return parseAddr(name, value)
+}
+
+func parsePrefix(name, value string) (*netip.Prefix, error) {
+	if value == "" {
+		return nil, nil
+	}
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", name, err)
+	}
+	return &prefix, nil
+}
+
+func parseRequiredPrefix(name, value string) (*netip.Prefix, error) {
+	if value == "" {
+		return nil, fmt.Errorf("%s is required", name)
+	}
+	return parsePrefix(name, value)
+}
+
+func parseLbports(name string, values []string) ([]api.LBPort, error) {
+	ports := make([]api.LBPort, 0, len(values))
+	for _, value := range values {
+		port, err := api.StringLbportToLbport(value)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing %s %s: %w", name, value, err)
+		}
+		ports = append(ports, port)
+	}
+	return ports, nil
+}
+
+func parseVNI(value string) (uint32, error) {
+	vni, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing vni: %w", err)
+	}
+	return uint32(vni), nil
+}
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Command dpservice-exporter exports the objects held by a dp-service node as Prometheus metrics.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ironcore-dev/dpservice-go/client"
+	"github.com/ironcore-dev/dpservice-go/exporter"
+	"github.com/ironcore-dev/dpservice-go/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+type options struct {
+	address       string
+	caFile        string
+	certFile      string
+	keyFile       string
+	listenAddress string
+	interval      time.Duration
+	loadBalancers []string
+	natIPs        []string
+}
+
+func newRootCommand() *cobra.Command {
+	o := &options{}
+
+	cmd := &cobra.Command{
+		Use:           "dpservice-exporter",
+		Short:         "Export the objects held by a dp-service node as Prometheus metrics",
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return run(cmd.Context(), o)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&o.address, "address", "localhost:1337", "dp-service address, host:port or path to a unix socket")
+	flags.StringVar(&o.caFile, "ca-file", "", "CA used to verify dp-service, enables TLS")
+	flags.StringVar(&o.certFile, "cert-file", "", "client certificate for mutual TLS")
+	flags.StringVar(&o.keyFile, "key-file", "", "client key for mutual TLS")
+	flags.StringVar(&o.listenAddress, "listen-address", ":9064", "address to serve /metrics on")
+	flags.DurationVar(&o.interval, "interval", 30*time.Second, "interval of walking dp-service")
+	flags.StringSliceVar(&o.loadBalancers, "loadbalancer", nil, "ID of a load balancer whose targets are exported, can be repeated")
+	flags.StringSliceVar(&o.natIPs, "nat-ip", nil, "NAT IP whose neighbor NATs are exported in addition to the ones used by interfaces, can be repeated")
+	return cmd
+}
+
+func run(ctx context.Context, o *options) error {
+	var natIPs []netip.Addr
+	for _, s := range o.natIPs {
+		ip, err := netip.ParseAddr(s)
+		if err != nil {
+			return fmt.Errorf("invalid --nat-ip: %w", err)
+		}
+		natIPs = append(natIPs, ip)
+	}
+
+	clientMetrics := metrics.New()
+	dialOpts := []client.Option{
+		client.WithUserAgent("dpservice-exporter"),
+		client.WithNonBlocking(),
+		client.WithDialOptions(grpc.WithChainUnaryInterceptor(clientMetrics.UnaryClientInterceptor())),
+	}
+	if o.caFile != "" || o.certFile != "" || o.keyFile != "" {
+		dialOpts = append(dialOpts, client.WithTLSFromFiles(o.caFile, o.certFile, o.keyFile))
+	}
+	c, err := client.Dial(ctx, o.address, dialOpts...)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	col := exporter.New(c,
+		exporter.WithLoadBalancers(o.loadBalancers...),
+		exporter.WithNatIPs(natIPs...),
+		exporter.WithErrorHandler(func(err error) {
+			fmt.Fprintln(os.Stderr, "Error refreshing metrics:", err)
+		}),
+	)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		col,
+		clientMetrics,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	server := &http.Server{Addr: o.listenAddress, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	go col.Run(ctx, o.interval)
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := newRootCommand().ExecuteContext(ctx); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,1140 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package dpservicetest
+
+import (
+	"context"
+	"net/netip"
+	"sort"
+	"strings"
+
+	"github.com/ironcore-dev/dpservice-go/errors"
+	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *Server) CheckInitialized(_ context.Context, _ *dpdkproto.CheckInitializedRequest) (*dpdkproto.CheckInitializedResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	return &dpdkproto.CheckInitializedResponse{Status: newStatus(0), Uuid: s.uuid}, nil
+}
+
+func (s *Server) Initialize(_ context.Context, _ *dpdkproto.InitializeRequest) (*dpdkproto.InitializeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.initialized {
+		s.uuid = newUUID()
+		s.initialized = true
+	}
+	return &dpdkproto.InitializeResponse{Status: newStatus(0), Uuid: s.uuid}, nil
+}
+
+func (s *Server) GetVersion(_ context.Context, _ *dpdkproto.GetVersionRequest) (*dpdkproto.GetVersionResponse, error) {
+	return &dpdkproto.GetVersionResponse{
+		Status:          newStatus(0),
+		ServiceProtocol: strings.TrimSpace(dpdkproto.GeneratedFrom),
+		ServiceVersion:  ServiceVersion,
+	}, nil
+}
+
+// Interfaces
+
+func (i *iface) toProto() *dpdkproto.Interface {
+	metering := &dpdkproto.MeteringParams{}
+	// TAP devices do not support metering, dp-service reports zero rates for them.
+	if i.metering != nil && !strings.HasPrefix(i.device, "net_tap") {
+		metering = proto.Clone(i.metering).(*dpdkproto.MeteringParams)
+	}
+	return &dpdkproto.Interface{
+		Id:             []byte(i.id),
+		Vni:            i.vni,
+		PrimaryIpv4:    []byte(i.ipv4.String()),
+		PrimaryIpv6:    []byte(i.ipv6.String()),
+		UnderlayRoute:  []byte(i.underlayRoute.String()),
+		PciName:        i.device,
+		MeteringParams: metering,
+	}
+}
+
+func (s *Server) ListInterfaces(_ context.Context, _ *dpdkproto.ListInterfacesRequest) (*dpdkproto.ListInterfacesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(s.interfaces))
+	for id := range s.interfaces {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	ifaces := make([]*dpdkproto.Interface, 0, len(ids))
+	for _, id := range ids {
+		ifaces = append(ifaces, s.interfaces[id].toProto())
+	}
+	return &dpdkproto.ListInterfacesResponse{Status: newStatus(0), Interfaces: ifaces}, nil
+}
+
+func (s *Server) GetInterface(_ context.Context, req *dpdkproto.GetInterfaceRequest) (*dpdkproto.GetInterfaceResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	id, ok := parseID(req.GetInterfaceId())
+	if !ok {
+		return nil, invalidArgument("interface_id")
+	}
+
+	i, ok := s.interfaces[id]
+	if !ok {
+		return &dpdkproto.GetInterfaceResponse{Status: newStatus(errors.NOT_FOUND)}, nil
+	}
+	return &dpdkproto.GetInterfaceResponse{Status: newStatus(0), Interface: i.toProto()}, nil
+}
+
+func (s *Server) CreateInterface(_ context.Context, req *dpdkproto.CreateInterfaceRequest) (*dpdkproto.CreateInterfaceResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+
+	id, ok := parseID(req.GetInterfaceId())
+	if !ok {
+		return nil, invalidArgument("interface_id")
+	}
+	if req.GetInterfaceType() != dpdkproto.InterfaceType_VIRTUAL && req.GetInterfaceType() != dpdkproto.InterfaceType_BAREMETAL {
+		return nil, invalidArgument("interface_type")
+	}
+	ipv4, ok := parseAddr(req.GetIpv4Config().GetPrimaryAddress())
+	if !ok || !ipv4.Is4() {
+		return nil, invalidArgument("ipv4_config.primary_address")
+	}
+	ipv6, ok := parseAddr(req.GetIpv6Config().GetPrimaryAddress())
+	if !ok || !ipv6.Is6() {
+		return nil, invalidArgument("ipv6_config.primary_address")
+	}
+	if req.GetDeviceName() == "" {
+		return nil, invalidArgument("device_name")
+	}
+
+	if _, ok := s.interfaces[id]; ok {
+		return &dpdkproto.CreateInterfaceResponse{Status: newStatus(errors.ALREADY_EXISTS)}, nil
+	}
+	for _, i := range s.interfaces {
+		if i.device == req.GetDeviceName() {
+			return &dpdkproto.CreateInterfaceResponse{Status: newStatus(errors.ALREADY_EXISTS)}, nil
+		}
+	}
+
+	i := &iface{
+		id:            id,
+		ifaceType:     req.GetInterfaceType(),
+		vni:           req.GetVni(),
+		ipv4:          ipv4,
+		ipv6:          ipv6,
+		device:        req.GetDeviceName(),
+		underlayRoute: s.nextUnderlayRoute(),
+		prefixes:      map[netip.Prefix]netip.Addr{},
+		lbPrefixes:    map[netip.Prefix]netip.Addr{},
+		fwRules:       map[string]*dpdkproto.FirewallRule{},
+	}
+	if req.GetPxeConfig() != nil {
+		i.pxe = proto.Clone(req.GetPxeConfig()).(*dpdkproto.PxeConfig)
+	}
+	if req.GetMeteringParameters() != nil {
+		i.metering = proto.Clone(req.GetMeteringParameters()).(*dpdkproto.MeteringParams)
+	}
+	s.interfaces[id] = i
+
+	return &dpdkproto.CreateInterfaceResponse{
+		Status:        newStatus(0),
+		UnderlayRoute: []byte(i.underlayRoute.String()),
+		Vf:            &dpdkproto.VirtualFunction{Name: i.device},
+	}, nil
+}
+
+func (s *Server) DeleteInterface(_ context.Context, req *dpdkproto.DeleteInterfaceRequest) (*dpdkproto.DeleteInterfaceResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	id, ok := parseID(req.GetInterfaceId())
+	if !ok {
+		return nil, invalidArgument("interface_id")
+	}
+
+	i, ok := s.interfaces[id]
+	if !ok {
+		return &dpdkproto.DeleteInterfaceResponse{Status: newStatus(errors.NOT_FOUND)}, nil
+	}
+	delete(s.interfaces, id)
+	s.releaseVNI(i.vni)
+	return &dpdkproto.DeleteInterfaceResponse{Status: newStatus(0)}, nil
+}
+
+// Prefixes
+
+func listPrefixes(prefixes map[netip.Prefix]netip.Addr) []*dpdkproto.Prefix {
+	keys := make([]netip.Prefix, 0, len(prefixes))
+	for prefix := range prefixes {
+		keys = append(keys, prefix)
+	}
+	sort.Slice(keys, func(a, b int) bool {
+		return keys[a].String() < keys[b].String()
+	})
+
+	res := make([]*dpdkproto.Prefix, 0, len(keys))
+	for _, prefix := range keys {
+		res = append(res, protoPrefix(prefix, prefixes[prefix]))
+	}
+	return res
+}
+
+func (s *Server) ListPrefixes(_ context.Context, req *dpdkproto.ListPrefixesRequest) (*dpdkproto.ListPrefixesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	id, ok := parseID(req.GetInterfaceId())
+	if !ok {
+		return nil, invalidArgument("interface_id")
+	}
+
+	i, ok := s.interfaces[id]
+	if !ok {
+		return &dpdkproto.ListPrefixesResponse{Status: newStatus(errors.NO_VM)}, nil
+	}
+	return &dpdkproto.ListPrefixesResponse{Status: newStatus(0), Prefixes: listPrefixes(i.prefixes)}, nil
+}
+
+func (s *Server) CreatePrefix(_ context.Context, req *dpdkproto.CreatePrefixRequest) (*dpdkproto.CreatePrefixResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	prefix, err := parsePrefix(req.GetPrefix(), "prefix")
+	if err != nil {
+		return nil, err
+	}
+	id, ok := parseID(req.GetInterfaceId())
+	if !ok {
+		return nil, invalidArgument("interface_id")
+	}
+
+	i, ok := s.interfaces[id]
+	if !ok {
+		return &dpdkproto.CreatePrefixResponse{Status: newStatus(errors.NO_VM)}, nil
+	}
+	if _, ok := i.prefixes[prefix]; ok {
+		return &dpdkproto.CreatePrefixResponse{Status: newStatus(errors.ROUTE_EXISTS)}, nil
+	}
+	underlayRoute := s.nextUnderlayRoute()
+	i.prefixes[prefix] = underlayRoute
+	return &dpdkproto.CreatePrefixResponse{Status: newStatus(0), UnderlayRoute: []byte(underlayRoute.String())}, nil
+}
+
+func (s *Server) DeletePrefix(_ context.Context, req *dpdkproto.DeletePrefixRequest) (*dpdkproto.DeletePrefixResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	prefix, err := parsePrefix(req.GetPrefix(), "prefix")
+	if err != nil {
+		return nil, err
+	}
+	id, ok := parseID(req.GetInterfaceId())
+	if !ok {
+		return nil, invalidArgument("interface_id")
+	}
+
+	i, ok := s.interfaces[id]
+	if !ok {
+		return &dpdkproto.DeletePrefixResponse{Status: newStatus(errors.NO_VM)}, nil
+	}
+	if _, ok := i.prefixes[prefix]; !ok {
+		return &dpdkproto.DeletePrefixResponse{Status: newStatus(errors.ROUTE_NOT_FOUND)}, nil
+	}
+	delete(i.prefixes, prefix)
+	return &dpdkproto.DeletePrefixResponse{Status: newStatus(0)}, nil
+}
+
+// LoadBalancer prefixes
+
+func (s *Server) ListLoadBalancerPrefixes(_ context.Context, req *dpdkproto.ListLoadBalancerPrefixesRequest) (*dpdkproto.ListLoadBalancerPrefixesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	id, ok := parseID(req.GetInterfaceId())
+	if !ok {
+		return nil, invalidArgument("interface_id")
+	}
+
+	i, ok := s.interfaces[id]
+	if !ok {
+		return &dpdkproto.ListLoadBalancerPrefixesResponse{Status: newStatus(errors.NO_VM)}, nil
+	}
+	return &dpdkproto.ListLoadBalancerPrefixesResponse{Status: newStatus(0), Prefixes: listPrefixes(i.lbPrefixes)}, nil
+}
+
+func (s *Server) CreateLoadBalancerPrefix(_ context.Context, req *dpdkproto.CreateLoadBalancerPrefixRequest) (*dpdkproto.CreateLoadBalancerPrefixResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	prefix, err := parsePrefix(req.GetPrefix(), "prefix")
+	if err != nil {
+		return nil, err
+	}
+	id, ok := parseID(req.GetInterfaceId())
+	if !ok {
+		return nil, invalidArgument("interface_id")
+	}
+
+	i, ok := s.interfaces[id]
+	if !ok {
+		return &dpdkproto.CreateLoadBalancerPrefixResponse{Status: newStatus(errors.NO_VM)}, nil
+	}
+	if _, ok := i.lbPrefixes[prefix]; ok {
+		return &dpdkproto.CreateLoadBalancerPrefixResponse{Status: newStatus(errors.ALREADY_EXISTS)}, nil
+	}
+	underlayRoute := s.nextUnderlayRoute()
+	i.lbPrefixes[prefix] = underlayRoute
+	return &dpdkproto.CreateLoadBalancerPrefixResponse{Status: newStatus(0), UnderlayRoute: []byte(underlayRoute.String())}, nil
+}
+
+func (s *Server) DeleteLoadBalancerPrefix(_ context.Context, req *dpdkproto.DeleteLoadBalancerPrefixRequest) (*dpdkproto.DeleteLoadBalancerPrefixResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	prefix, err := parsePrefix(req.GetPrefix(), "prefix")
+	if err != nil {
+		return nil, err
+	}
+	id, ok := parseID(req.GetInterfaceId())
+	if !ok {
+		return nil, invalidArgument("interface_id")
+	}
+
+	i, ok := s.interfaces[id]
+	if !ok {
+		return &dpdkproto.DeleteLoadBalancerPrefixResponse{Status: newStatus(errors.NO_VM)}, nil
+	}
+	if _, ok := i.lbPrefixes[prefix]; !ok {
+		return &dpdkproto.DeleteLoadBalancerPrefixResponse{Status: newStatus(errors.NOT_FOUND)}, nil
+	}
+	delete(i.lbPrefixes, prefix)
+	return &dpdkproto.DeleteLoadBalancerPrefixResponse{Status: newStatus(0)}, nil
+}
+
+// Virtual IPs
+
+func (s *Server) CreateVip(_ context.Context, req *dpdkproto.CreateVipRequest) (*dpdkproto.CreateVipResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	ip, ok := parseIP(req.GetVipIp())
+	if !ok || !ip.Is4() {
+		return nil, invalidArgument("vip_ip")
+	}
+	id, ok := parseID(req.GetInterfaceId())
+	if !ok {
+		return nil, invalidArgument("interface_id")
+	}
+
+	i, ok := s.interfaces[id]
+	if !ok {
+		return &dpdkproto.CreateVipResponse{Status: newStatus(errors.NO_VM)}, nil
+	}
+	if i.vip != nil {
+		return &dpdkproto.CreateVipResponse{Status: newStatus(errors.SNAT_EXISTS)}, nil
+	}
+	i.vip = &vip{ip: ip, underlayRoute: s.nextUnderlayRoute()}
+	return &dpdkproto.CreateVipResponse{Status: newStatus(0), UnderlayRoute: []byte(i.vip.underlayRoute.String())}, nil
+}
+
+func (s *Server) GetVip(_ context.Context, req *dpdkproto.GetVipRequest) (*dpdkproto.GetVipResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	id, ok := parseID(req.GetInterfaceId())
+	if !ok {
+		return nil, invalidArgument("interface_id")
+	}
+
+	i, ok := s.interfaces[id]
+	if !ok {
+		return &dpdkproto.GetVipResponse{Status: newStatus(errors.NO_VM)}, nil
+	}
+	if i.vip == nil {
+		return &dpdkproto.GetVipResponse{Status: newStatus(errors.SNAT_NO_DATA)}, nil
+	}
+	return &dpdkproto.GetVipResponse{
+		Status:        newStatus(0),
+		VipIp:         protoIP(i.vip.ip),
+		UnderlayRoute: []byte(i.vip.underlayRoute.String()),
+	}, nil
+}
+
+func (s *Server) DeleteVip(_ context.Context, req *dpdkproto.DeleteVipRequest) (*dpdkproto.DeleteVipResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	id, ok := parseID(req.GetInterfaceId())
+	if !ok {
+		return nil, invalidArgument("interface_id")
+	}
+
+	i, ok := s.interfaces[id]
+	if !ok {
+		return &dpdkproto.DeleteVipResponse{Status: newStatus(errors.NO_VM)}, nil
+	}
+	if i.vip == nil {
+		return &dpdkproto.DeleteVipResponse{Status: newStatus(errors.SNAT_NO_DATA)}, nil
+	}
+	i.vip = nil
+	return &dpdkproto.DeleteVipResponse{Status: newStatus(0)}, nil
+}
+
+// LoadBalancers
+
+func (s *Server) CreateLoadBalancer(_ context.Context, req *dpdkproto.CreateLoadBalancerRequest) (*dpdkproto.CreateLoadBalancerResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	id, ok := parseID(req.GetLoadbalancerId())
+	if !ok {
+		return nil, invalidArgument("loadbalancer_id")
+	}
+	ip, ok := parseIP(req.GetLoadbalancedIp())
+	if !ok {
+		return nil, invalidArgument("loadbalanced_ip")
+	}
+	ports := make([]*dpdkproto.LbPort, 0, len(req.GetLoadbalancedPorts()))
+	for _, port := range req.GetLoadbalancedPorts() {
+		switch port.GetProtocol() {
+		case dpdkproto.Protocol_TCP, dpdkproto.Protocol_UDP:
+		default:
+			return nil, invalidArgument("loadbalanced_ports.protocol")
+		}
+		if port.GetPort() > 0xffff {
+			return nil, invalidArgument("loadbalanced_ports.port")
+		}
+		ports = append(ports, proto.Clone(port).(*dpdkproto.LbPort))
+	}
+
+	if _, ok := s.loadBalancers[id]; ok {
+		return &dpdkproto.CreateLoadBalancerResponse{Status: newStatus(errors.ALREADY_EXISTS)}, nil
+	}
+	lb := &loadBalancer{
+		id:            id,
+		vni:           req.GetVni(),
+		ip:            ip,
+		ports:         ports,
+		underlayRoute: s.nextUnderlayRoute(),
+		targets:       map[netip.Addr]struct{}{},
+	}
+	s.loadBalancers[id] = lb
+	return &dpdkproto.CreateLoadBalancerResponse{Status: newStatus(0), UnderlayRoute: []byte(lb.underlayRoute.String())}, nil
+}
+
+func (s *Server) GetLoadBalancer(_ context.Context, req *dpdkproto.GetLoadBalancerRequest) (*dpdkproto.GetLoadBalancerResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	id, ok := parseID(req.GetLoadbalancerId())
+	if !ok {
+		return nil, invalidArgument("loadbalancer_id")
+	}
+
+	lb, ok := s.loadBalancers[id]
+	if !ok {
+		return &dpdkproto.GetLoadBalancerResponse{Status: newStatus(errors.NOT_FOUND)}, nil
+	}
+	ports := make([]*dpdkproto.LbPort, 0, len(lb.ports))
+	for _, port := range lb.ports {
+		ports = append(ports, proto.Clone(port).(*dpdkproto.LbPort))
+	}
+	return &dpdkproto.GetLoadBalancerResponse{
+		Status:            newStatus(0),
+		LoadbalancedIp:    protoIP(lb.ip),
+		Vni:               lb.vni,
+		LoadbalancedPorts: ports,
+		UnderlayRoute:     []byte(lb.underlayRoute.String()),
+	}, nil
+}
+
+func (s *Server) DeleteLoadBalancer(_ context.Context, req *dpdkproto.DeleteLoadBalancerRequest) (*dpdkproto.DeleteLoadBalancerResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	id, ok := parseID(req.GetLoadbalancerId())
+	if !ok {
+		return nil, invalidArgument("loadbalancer_id")
+	}
+
+	lb, ok := s.loadBalancers[id]
+	if !ok {
+		return &dpdkproto.DeleteLoadBalancerResponse{Status: newStatus(errors.NOT_FOUND)}, nil
+	}
+	delete(s.loadBalancers, id)
+	s.releaseVNI(lb.vni)
+	return &dpdkproto.DeleteLoadBalancerResponse{Status: newStatus(0)}, nil
+}
+
+// LoadBalancer targets
+
+func (s *Server) CreateLoadBalancerTarget(_ context.Context, req *dpdkproto.CreateLoadBalancerTargetRequest) (*dpdkproto.CreateLoadBalancerTargetResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	id, ok := parseID(req.GetLoadbalancerId())
+	if !ok {
+		return nil, invalidArgument("loadbalancer_id")
+	}
+	target, ok := parseIP(req.GetTargetIp())
+	if !ok || !target.Is6() {
+		return nil, invalidArgument("target_ip")
+	}
+
+	lb, ok := s.loadBalancers[id]
+	if !ok {
+		return &dpdkproto.CreateLoadBalancerTargetResponse{Status: newStatus(errors.NO_LB)}, nil
+	}
+	if _, ok := lb.targets[target]; ok {
+		return &dpdkproto.CreateLoadBalancerTargetResponse{Status: newStatus(errors.ALREADY_EXISTS)}, nil
+	}
+	lb.targets[target] = struct{}{}
+	return &dpdkproto.CreateLoadBalancerTargetResponse{Status: newStatus(0)}, nil
+}
+
+func (s *Server) ListLoadBalancerTargets(_ context.Context, req *dpdkproto.ListLoadBalancerTargetsRequest) (*dpdkproto.ListLoadBalancerTargetsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	id, ok := parseID(req.GetLoadbalancerId())
+	if !ok {
+		return nil, invalidArgument("loadbalancer_id")
+	}
+
+	lb, ok := s.loadBalancers[id]
+	if !ok {
+		return &dpdkproto.ListLoadBalancerTargetsResponse{Status: newStatus(errors.NO_LB)}, nil
+	}
+	targets := make([]netip.Addr, 0, len(lb.targets))
+	for target := range lb.targets {
+		targets = append(targets, target)
+	}
+	sort.Slice(targets, func(a, b int) bool {
+		return targets[a].Less(targets[b])
+	})
+	targetIPs := make([]*dpdkproto.IpAddress, 0, len(targets))
+	for _, target := range targets {
+		targetIPs = append(targetIPs, protoIP(target))
+	}
+	return &dpdkproto.ListLoadBalancerTargetsResponse{Status: newStatus(0), TargetIps: targetIPs}, nil
+}
+
+func (s *Server) DeleteLoadBalancerTarget(_ context.Context, req *dpdkproto.DeleteLoadBalancerTargetRequest) (*dpdkproto.DeleteLoadBalancerTargetResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	id, ok := parseID(req.GetLoadbalancerId())
+	if !ok {
+		return nil, invalidArgument("loadbalancer_id")
+	}
+	target, ok := parseIP(req.GetTargetIp())
+	if !ok {
+		return nil, invalidArgument("target_ip")
+	}
+
+	lb, ok := s.loadBalancers[id]
+	if !ok {
+		return &dpdkproto.DeleteLoadBalancerTargetResponse{Status: newStatus(errors.NO_LB)}, nil
+	}
+	if _, ok := lb.targets[target]; !ok {
+		return &dpdkproto.DeleteLoadBalancerTargetResponse{Status: newStatus(errors.NOT_FOUND)}, nil
+	}
+	delete(lb.targets, target)
+	return &dpdkproto.DeleteLoadBalancerTargetResponse{Status: newStatus(0)}, nil
+}
+
+// NATs
+
+func validatePortRange(minPort, maxPort uint32) error {
+	if minPort > 0xffff {
+		return invalidArgument("min_port")
+	}
+	if maxPort > 0xffff {
+		return invalidArgument("max_port")
+	}
+	if minPort >= maxPort {
+		return invalidArgument("port range")
+	}
+	return nil
+}
+
+func (s *Server) CreateNat(_ context.Context, req *dpdkproto.CreateNatRequest) (*dpdkproto.CreateNatResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	id, ok := parseID(req.GetInterfaceId())
+	if !ok {
+		return nil, invalidArgument("interface_id")
+	}
+	ip, ok := parseIP(req.GetNatIp())
+	if !ok || !ip.Is4() {
+		return nil, invalidArgument("nat_ip")
+	}
+	if err := validatePortRange(req.GetMinPort(), req.GetMaxPort()); err != nil {
+		return nil, err
+	}
+
+	i, ok := s.interfaces[id]
+	if !ok {
+		return &dpdkproto.CreateNatResponse{Status: newStatus(errors.NO_VM)}, nil
+	}
+	if i.nat != nil {
+		return &dpdkproto.CreateNatResponse{Status: newStatus(errors.SNAT_EXISTS)}, nil
+	}
+	i.nat = &nat{
+		ip:            ip,
+		minPort:       req.GetMinPort(),
+		maxPort:       req.GetMaxPort(),
+		underlayRoute: s.nextUnderlayRoute(),
+	}
+	return &dpdkproto.CreateNatResponse{Status: newStatus(0), UnderlayRoute: []byte(i.nat.underlayRoute.String())}, nil
+}
+
+func (s *Server) GetNat(_ context.Context, req *dpdkproto.GetNatRequest) (*dpdkproto.GetNatResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	id, ok := parseID(req.GetInterfaceId())
+	if !ok {
+		return nil, invalidArgument("interface_id")
+	}
+
+	i, ok := s.interfaces[id]
+	if !ok {
+		return &dpdkproto.GetNatResponse{Status: newStatus(errors.NO_VM)}, nil
+	}
+	if i.nat == nil {
+		return &dpdkproto.GetNatResponse{Status: newStatus(errors.SNAT_NO_DATA)}, nil
+	}
+	return &dpdkproto.GetNatResponse{
+		Status:        newStatus(0),
+		NatIp:         protoIP(i.nat.ip),
+		MinPort:       i.nat.minPort,
+		MaxPort:       i.nat.maxPort,
+		UnderlayRoute: []byte(i.nat.underlayRoute.String()),
+	}, nil
+}
+
+func (s *Server) DeleteNat(_ context.Context, req *dpdkproto.DeleteNatRequest) (*dpdkproto.DeleteNatResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	id, ok := parseID(req.GetInterfaceId())
+	if !ok {
+		return nil, invalidArgument("interface_id")
+	}
+
+	i, ok := s.interfaces[id]
+	if !ok {
+		return &dpdkproto.DeleteNatResponse{Status: newStatus(errors.NO_VM)}, nil
+	}
+	if i.nat == nil {
+		return &dpdkproto.DeleteNatResponse{Status: newStatus(errors.SNAT_NO_DATA)}, nil
+	}
+	i.nat = nil
+	return &dpdkproto.DeleteNatResponse{Status: newStatus(0)}, nil
+}
+
+func (s *Server) ListLocalNats(_ context.Context, req *dpdkproto.ListLocalNatsRequest) (*dpdkproto.ListLocalNatsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	natIP, ok := parseIP(req.GetNatIp())
+	if !ok {
+		return nil, invalidArgument("nat_ip")
+	}
+
+	var entries []*dpdkproto.NatEntry
+	for _, i := range s.sortedInterfaces() {
+		if i.nat == nil || i.nat.ip != natIP {
+			continue
+		}
+		entries = append(entries, &dpdkproto.NatEntry{
+			NatIp:   protoIP(i.ipv4),
+			MinPort: i.nat.minPort,
+			MaxPort: i.nat.maxPort,
+			Vni:     i.vni,
+		})
+	}
+	return &dpdkproto.ListLocalNatsResponse{Status: newStatus(0), NatEntries: entries}, nil
+}
+
+func (s *Server) sortedInterfaces() []*iface {
+	ifaces := make([]*iface, 0, len(s.interfaces))
+	for _, i := range s.interfaces {
+		ifaces = append(ifaces, i)
+	}
+	sort.Slice(ifaces, func(a, b int) bool {
+		return ifaces[a].id < ifaces[b].id
+	})
+	return ifaces
+}
+
+func (s *Server) CreateNeighborNat(_ context.Context, req *dpdkproto.CreateNeighborNatRequest) (*dpdkproto.CreateNeighborNatResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	ip, ok := parseIP(req.GetNatIp())
+	if !ok || !ip.Is4() {
+		return nil, invalidArgument("nat_ip")
+	}
+	underlayRoute, ok := parseAddr(req.GetUnderlayRoute())
+	if !ok || !underlayRoute.Is6() {
+		return nil, invalidArgument("underlay_route")
+	}
+	if err := validatePortRange(req.GetMinPort(), req.GetMaxPort()); err != nil {
+		return nil, err
+	}
+
+	key := neighborNatKey{ip: ip, vni: req.GetVni(), minPort: req.GetMinPort(), maxPort: req.GetMaxPort()}
+	if _, ok := s.neighborNats[key]; ok {
+		return &dpdkproto.CreateNeighborNatResponse{Status: newStatus(errors.ALREADY_EXISTS)}, nil
+	}
+	s.neighborNats[key] = underlayRoute
+	return &dpdkproto.CreateNeighborNatResponse{Status: newStatus(0)}, nil
+}
+
+func (s *Server) DeleteNeighborNat(_ context.Context, req *dpdkproto.DeleteNeighborNatRequest) (*dpdkproto.DeleteNeighborNatResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	ip, ok := parseIP(req.GetNatIp())
+	if !ok {
+		return nil, invalidArgument("nat_ip")
+	}
+	if err := validatePortRange(req.GetMinPort(), req.GetMaxPort()); err != nil {
+		return nil, err
+	}
+
+	key := neighborNatKey{ip: ip, vni: req.GetVni(), minPort: req.GetMinPort(), maxPort: req.GetMaxPort()}
+	if _, ok := s.neighborNats[key]; !ok {
+		return &dpdkproto.DeleteNeighborNatResponse{Status: newStatus(errors.NOT_FOUND)}, nil
+	}
+	delete(s.neighborNats, key)
+	return &dpdkproto.DeleteNeighborNatResponse{Status: newStatus(0)}, nil
+}
+
+func (s *Server) ListNeighborNats(_ context.Context, req *dpdkproto.ListNeighborNatsRequest) (*dpdkproto.ListNeighborNatsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	natIP, ok := parseIP(req.GetNatIp())
+	if !ok {
+		return nil, invalidArgument("nat_ip")
+	}
+
+	keys := make([]neighborNatKey, 0, len(s.neighborNats))
+	for key := range s.neighborNats {
+		if key.ip == natIP {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(a, b int) bool {
+		if keys[a].vni != keys[b].vni {
+			return keys[a].vni < keys[b].vni
+		}
+		return keys[a].minPort < keys[b].minPort
+	})
+
+	entries := make([]*dpdkproto.NatEntry, 0, len(keys))
+	for _, key := range keys {
+		entries = append(entries, &dpdkproto.NatEntry{
+			MinPort:       key.minPort,
+			MaxPort:       key.maxPort,
+			UnderlayRoute: []byte(s.neighborNats[key].String()),
+			Vni:           key.vni,
+		})
+	}
+	return &dpdkproto.ListNeighborNatsResponse{Status: newStatus(0), NatEntries: entries}, nil
+}
+
+// Routes
+
+func (s *Server) ListRoutes(_ context.Context, req *dpdkproto.ListRoutesRequest) (*dpdkproto.ListRoutesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+
+	if !s.vniInUse(req.GetVni()) {
+		return &dpdkproto.ListRoutesResponse{Status: newStatus(errors.NO_VNI)}, nil
+	}
+	table := s.routes[req.GetVni()]
+	prefixes := make([]netip.Prefix, 0, len(table))
+	for prefix := range table {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Slice(prefixes, func(a, b int) bool {
+		return prefixes[a].String() < prefixes[b].String()
+	})
+
+	routes := make([]*dpdkproto.Route, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		r := table[prefix]
+		routes = append(routes, &dpdkproto.Route{
+			Prefix:         protoPrefix(r.prefix, netip.Addr{}),
+			NexthopVni:     r.nextHopVNI,
+			NexthopAddress: protoIP(r.nextHop),
+			Weight:         100,
+		})
+	}
+	return &dpdkproto.ListRoutesResponse{Status: newStatus(0), Routes: routes}, nil
+}
+
+func (s *Server) CreateRoute(_ context.Context, req *dpdkproto.CreateRouteRequest) (*dpdkproto.CreateRouteResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	prefix, err := parsePrefix(req.GetRoute().GetPrefix(), "route.prefix")
+	if err != nil {
+		return nil, err
+	}
+	nextHop, ok := parseIP(req.GetRoute().GetNexthopAddress())
+	if !ok || !nextHop.Is6() {
+		return nil, invalidArgument("route.nexthop_address")
+	}
+
+	if !s.vniInUse(req.GetVni()) {
+		return &dpdkproto.CreateRouteResponse{Status: newStatus(errors.NO_VNI)}, nil
+	}
+	table, ok := s.routes[req.GetVni()]
+	if !ok {
+		table = map[netip.Prefix]route{}
+		s.routes[req.GetVni()] = table
+	}
+	if _, ok := table[prefix]; ok {
+		return &dpdkproto.CreateRouteResponse{Status: newStatus(errors.ROUTE_EXISTS)}, nil
+	}
+	table[prefix] = route{prefix: prefix, nextHopVNI: req.GetRoute().GetNexthopVni(), nextHop: nextHop}
+	return &dpdkproto.CreateRouteResponse{Status: newStatus(0)}, nil
+}
+
+func (s *Server) DeleteRoute(_ context.Context, req *dpdkproto.DeleteRouteRequest) (*dpdkproto.DeleteRouteResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	prefix, err := parsePrefix(req.GetRoute().GetPrefix(), "route.prefix")
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.vniInUse(req.GetVni()) {
+		return &dpdkproto.DeleteRouteResponse{Status: newStatus(errors.NO_VNI)}, nil
+	}
+	if _, ok := s.routes[req.GetVni()][prefix]; !ok {
+		return &dpdkproto.DeleteRouteResponse{Status: newStatus(errors.ROUTE_NOT_FOUND)}, nil
+	}
+	delete(s.routes[req.GetVni()], prefix)
+	return &dpdkproto.DeleteRouteResponse{Status: newStatus(0)}, nil
+}
+
+// VNIs
+
+func (s *Server) CheckVniInUse(_ context.Context, req *dpdkproto.CheckVniInUseRequest) (*dpdkproto.CheckVniInUseResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	if _, ok := dpdkproto.VniType_name[int32(req.GetType())]; !ok {
+		return nil, invalidArgument("type")
+	}
+	return &dpdkproto.CheckVniInUseResponse{Status: newStatus(0), InUse: s.vniInUse(req.GetVni())}, nil
+}
+
+func (s *Server) ResetVni(_ context.Context, req *dpdkproto.ResetVniRequest) (*dpdkproto.ResetVniResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	if _, ok := dpdkproto.VniType_name[int32(req.GetType())]; !ok {
+		return nil, invalidArgument("type")
+	}
+	delete(s.routes, req.GetVni())
+	return &dpdkproto.ResetVniResponse{Status: newStatus(0)}, nil
+}
+
+// Firewall rules
+
+func (s *Server) ListFirewallRules(_ context.Context, req *dpdkproto.ListFirewallRulesRequest) (*dpdkproto.ListFirewallRulesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	id, ok := parseID(req.GetInterfaceId())
+	if !ok {
+		return nil, invalidArgument("interface_id")
+	}
+
+	i, ok := s.interfaces[id]
+	if !ok {
+		return &dpdkproto.ListFirewallRulesResponse{Status: newStatus(errors.NO_VM)}, nil
+	}
+	ruleIDs := make([]string, 0, len(i.fwRules))
+	for ruleID := range i.fwRules {
+		ruleIDs = append(ruleIDs, ruleID)
+	}
+	sort.Strings(ruleIDs)
+
+	rules := make([]*dpdkproto.FirewallRule, 0, len(ruleIDs))
+	for _, ruleID := range ruleIDs {
+		rules = append(rules, proto.Clone(i.fwRules[ruleID]).(*dpdkproto.FirewallRule))
+	}
+	return &dpdkproto.ListFirewallRulesResponse{Status: newStatus(0), Rules: rules}, nil
+}
+
+func (s *Server) CreateFirewallRule(_ context.Context, req *dpdkproto.CreateFirewallRuleRequest) (*dpdkproto.CreateFirewallRuleResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	id, ok := parseID(req.GetInterfaceId())
+	if !ok {
+		return nil, invalidArgument("interface_id")
+	}
+	rule := req.GetRule()
+	ruleID, ok := parseID(rule.GetId())
+	if !ok {
+		return nil, invalidArgument("rule id")
+	}
+	if _, ok := dpdkproto.TrafficDirection_name[int32(rule.GetDirection())]; !ok {
+		return nil, invalidArgument("rule.direction")
+	}
+	if _, ok := dpdkproto.FirewallAction_name[int32(rule.GetAction())]; !ok {
+		return nil, invalidArgument("rule.action")
+	}
+	srcPrefix, err := parsePrefix(rule.GetSourcePrefix(), "rule.source_prefix")
+	if err != nil {
+		return nil, err
+	}
+	dstPrefix, err := parsePrefix(rule.GetDestinationPrefix(), "rule.destination_prefix")
+	if err != nil {
+		return nil, err
+	}
+	if err := validateProtocolFilter(rule.GetProtocolFilter()); err != nil {
+		return nil, err
+	}
+
+	i, ok := s.interfaces[id]
+	if !ok {
+		return &dpdkproto.CreateFirewallRuleResponse{Status: newStatus(errors.NO_VM)}, nil
+	}
+	if _, ok := i.fwRules[ruleID]; ok {
+		return &dpdkproto.CreateFirewallRuleResponse{Status: newStatus(errors.ALREADY_EXISTS)}, nil
+	}
+	stored := proto.Clone(rule).(*dpdkproto.FirewallRule)
+	stored.SourcePrefix = protoPrefix(srcPrefix, netip.Addr{})
+	stored.DestinationPrefix = protoPrefix(dstPrefix, netip.Addr{})
+	i.fwRules[ruleID] = stored
+	return &dpdkproto.CreateFirewallRuleResponse{Status: newStatus(0), RuleId: []byte(ruleID)}, nil
+}
+
+func (s *Server) GetFirewallRule(_ context.Context, req *dpdkproto.GetFirewallRuleRequest) (*dpdkproto.GetFirewallRuleResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	id, ok := parseID(req.GetInterfaceId())
+	if !ok {
+		return nil, invalidArgument("interface_id")
+	}
+	ruleID, ok := parseID(req.GetRuleId())
+	if !ok {
+		return nil, invalidArgument("rule_id")
+	}
+
+	i, ok := s.interfaces[id]
+	if !ok {
+		return &dpdkproto.GetFirewallRuleResponse{Status: newStatus(errors.NO_VM)}, nil
+	}
+	rule, ok := i.fwRules[ruleID]
+	if !ok {
+		return &dpdkproto.GetFirewallRuleResponse{Status: newStatus(errors.NOT_FOUND)}, nil
+	}
+	return &dpdkproto.GetFirewallRuleResponse{Status: newStatus(0), Rule: proto.Clone(rule).(*dpdkproto.FirewallRule)}, nil
+}
+
+func (s *Server) DeleteFirewallRule(_ context.Context, req *dpdkproto.DeleteFirewallRuleRequest) (*dpdkproto.DeleteFirewallRuleResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	id, ok := parseID(req.GetInterfaceId())
+	if !ok {
+		return nil, invalidArgument("interface_id")
+	}
+	ruleID, ok := parseID(req.GetRuleId())
+	if !ok {
+		return nil, invalidArgument("rule_id")
+	}
+
+	i, ok := s.interfaces[id]
+	if !ok {
+		return &dpdkproto.DeleteFirewallRuleResponse{Status: newStatus(errors.NO_VM)}, nil
+	}
+	if _, ok := i.fwRules[ruleID]; !ok {
+		return &dpdkproto.DeleteFirewallRuleResponse{Status: newStatus(errors.NOT_FOUND)}, nil
+	}
+	delete(i.fwRules, ruleID)
+	return &dpdkproto.DeleteFirewallRuleResponse{Status: newStatus(0)}, nil
+}
+
+// Packet capture
+
+func (s *Server) CaptureStart(_ context.Context, req *dpdkproto.CaptureStartRequest) (*dpdkproto.CaptureStartResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+	config := req.GetCaptureConfig()
+	sinkNodeIP, ok := parseIP(config.GetSinkNodeIp())
+	if !ok || !sinkNodeIP.Is6() {
+		return nil, invalidArgument("sink_node_ip")
+	}
+	if config.GetUdpSrcPort() > 0xffff {
+		return nil, invalidArgument("udp_src_port")
+	}
+	if config.GetUdpDstPort() > 0xffff {
+		return nil, invalidArgument("udp_dst_port")
+	}
+	for _, captured := range config.GetInterfaces() {
+		switch captured.GetInterfaceType() {
+		case dpdkproto.CaptureInterfaceType_SINGLE_PF:
+			if captured.GetPfIndex() > 1 {
+				return nil, invalidArgument("interfaces.pf_index")
+			}
+		case dpdkproto.CaptureInterfaceType_SINGLE_VF:
+			if len(captured.GetVfName()) == 0 {
+				return nil, invalidArgument("interfaces.vf_name")
+			}
+		default:
+			return nil, invalidArgument("interfaces.interface_type")
+		}
+	}
+
+	if s.capture != nil {
+		return &dpdkproto.CaptureStartResponse{Status: newStatus(errors.ALREADY_ACTIVE)}, nil
+	}
+	for _, captured := range config.GetInterfaces() {
+		if captured.GetInterfaceType() == dpdkproto.CaptureInterfaceType_SINGLE_VF && !s.deviceExists(string(captured.GetVfName())) {
+			return &dpdkproto.CaptureStartResponse{Status: newStatus(errors.NOT_FOUND)}, nil
+		}
+	}
+	s.capture = proto.Clone(config).(*dpdkproto.CaptureConfig)
+	return &dpdkproto.CaptureStartResponse{Status: newStatus(0)}, nil
+}
+
+func (s *Server) deviceExists(device string) bool {
+	for _, i := range s.interfaces {
+		if i.device == device {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) CaptureStop(_ context.Context, _ *dpdkproto.CaptureStopRequest) (*dpdkproto.CaptureStopResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+
+	if s.capture == nil {
+		return &dpdkproto.CaptureStopResponse{Status: newStatus(errors.NOT_ACTIVE)}, nil
+	}
+	count := uint32(len(s.capture.GetInterfaces()))
+	s.capture = nil
+	return &dpdkproto.CaptureStopResponse{Status: newStatus(0), StoppedInterfaceCnt: count}, nil
+}
+
+func (s *Server) CaptureStatus(_ context.Context, _ *dpdkproto.CaptureStatusRequest) (*dpdkproto.CaptureStatusResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkInitialized(); err != nil {
+		return nil, err
+	}
+
+	if s.capture == nil {
+		return &dpdkproto.CaptureStatusResponse{Status: newStatus(0)}, nil
+	}
+	return &dpdkproto.CaptureStatusResponse{
+		Status:        newStatus(0),
+		IsActive:      true,
+		CaptureConfig: proto.Clone(s.capture).(*dpdkproto.CaptureConfig),
+	}, nil
+}
@@ -0,0 +1,349 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package dpservicetest provides a stateful, in-memory implementation of the
+// dp-service gRPC API for testing code built on top of the client package
+// without a running dp-service.
+package dpservicetest
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+	"sync"
+
+	"github.com/ironcore-dev/dpservice-go/errors"
+	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	bufSize = 1024 * 1024
+
+	// ServiceVersion is reported by the fake in GetVersion responses.
+	ServiceVersion = "dpservicetest"
+)
+
+type vip struct {
+	ip            netip.Addr
+	underlayRoute netip.Addr
+}
+
+type nat struct {
+	ip            netip.Addr
+	minPort       uint32
+	maxPort       uint32
+	underlayRoute netip.Addr
+}
+
+type iface struct {
+	id            string
+	ifaceType     dpdkproto.InterfaceType
+	vni           uint32
+	ipv4          netip.Addr
+	ipv6          netip.Addr
+	device        string
+	underlayRoute netip.Addr
+	pxe           *dpdkproto.PxeConfig
+	metering      *dpdkproto.MeteringParams
+	prefixes      map[netip.Prefix]netip.Addr
+	lbPrefixes    map[netip.Prefix]netip.Addr
+	vip           *vip
+	nat           *nat
+	fwRules       map[string]*dpdkproto.FirewallRule
+}
+
+type loadBalancer struct {
+	id            string
+	vni           uint32
+	ip            netip.Addr
+	ports         []*dpdkproto.LbPort
+	underlayRoute netip.Addr
+	targets       map[netip.Addr]struct{}
+}
+
+type route struct {
+	prefix     netip.Prefix
+	nextHopVNI uint32
+	nextHop    netip.Addr
+}
+
+type neighborNatKey struct {
+	ip      netip.Addr
+	vni     uint32
+	minPort uint32
+	maxPort uint32
+}
+
+// Server is an in-memory fake of dp-service. It implements
+// dpdkproto.DPDKironcoreServer and keeps the same bookkeeping dp-service does,
+// returning the status codes defined in the errors package.
+//
+// The zero value is not usable, create instances with NewServer.
+type Server struct {
+	dpdkproto.UnimplementedDPDKironcoreServer
+
+	mu sync.Mutex
+
+	uuid          string
+	initialized   bool
+	underlayCount uint32
+
+	interfaces    map[string]*iface
+	loadBalancers map[string]*loadBalancer
+	routes        map[uint32]map[netip.Prefix]route
+	neighborNats  map[neighborNatKey]netip.Addr
+	capture       *dpdkproto.CaptureConfig
+
+	listener   *bufconn.Listener
+	grpcServer *grpc.Server
+}
+
+// NewServer returns a fresh, not yet initialized fake dp-service.
+func NewServer() *Server {
+	s := &Server{}
+	s.reset()
+	return s
+}
+
+func (s *Server) reset() {
+	s.uuid = ""
+	s.initialized = false
+	s.interfaces = map[string]*iface{}
+	s.loadBalancers = map[string]*loadBalancer{}
+	s.routes = map[uint32]map[netip.Prefix]route{}
+	s.neighborNats = map[neighborNatKey]netip.Addr{}
+	s.capture = nil
+}
+
+// Start serves the fake over an in-memory bufconn listener.
+// Use Dial to obtain a connection to it and Close to stop it.
+func (s *Server) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.grpcServer != nil {
+		return
+	}
+
+	s.listener = bufconn.Listen(bufSize)
+	s.grpcServer = grpc.NewServer()
+	dpdkproto.RegisterDPDKironcoreServer(s.grpcServer, s)
+
+	listener, grpcServer := s.listener, s.grpcServer
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+}
+
+// Dial connects to the fake started by Start. The returned connection uses
+// insecure transport credentials unless overridden by opts.
+func (s *Server) Dial(ctx context.Context, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	s.mu.Lock()
+	listener := s.listener
+	s.mu.Unlock()
+	if listener == nil {
+		return nil, fmt.Errorf("server is not started")
+	}
+
+	opts = append([]grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}, opts...)
+	return grpc.DialContext(ctx, "bufnet", opts...)
+}
+
+// Close stops serving. The in-memory state is kept.
+func (s *Server) Close() {
+	s.mu.Lock()
+	grpcServer := s.grpcServer
+	s.grpcServer = nil
+	s.listener = nil
+	s.mu.Unlock()
+
+	if grpcServer != nil {
+		grpcServer.Stop()
+	}
+}
+
+// Restart simulates a dp-service restart: all configuration is dropped and
+// the service has to be initialized again, which yields a new UUID.
+func (s *Server) Restart() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reset()
+}
+
+func newUUID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(fmt.Sprintf("error generating uuid: %v", err))
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// nextUnderlayRoute hands out unique underlay addresses from fc00:1::/64.
+func (s *Server) nextUnderlayRoute() netip.Addr {
+	s.underlayCount++
+	addr := netip.MustParseAddr("fc00:1::").As16()
+	addr[12] = byte(s.underlayCount >> 24)
+	addr[13] = byte(s.underlayCount >> 16)
+	addr[14] = byte(s.underlayCount >> 8)
+	addr[15] = byte(s.underlayCount)
+	return netip.AddrFrom16(addr)
+}
+
+func (s *Server) checkInitialized() error {
+	if !s.initialized {
+		return status.Error(codes.Aborted, "not initialized")
+	}
+	return nil
+}
+
+// vniInUse reports whether any interface or load balancer holds the VNI.
+func (s *Server) vniInUse(vni uint32) bool {
+	for _, i := range s.interfaces {
+		if i.vni == vni {
+			return true
+		}
+	}
+	for _, lb := range s.loadBalancers {
+		if lb.vni == vni {
+			return true
+		}
+	}
+	return false
+}
+
+// releaseVNI frees the routing table of a VNI once nothing uses it anymore.
+func (s *Server) releaseVNI(vni uint32) {
+	if !s.vniInUse(vni) {
+		delete(s.routes, vni)
+	}
+}
+
+func newStatus(code uint32) *dpdkproto.Status {
+	if code == 0 {
+		return &dpdkproto.Status{}
+	}
+	return &dpdkproto.Status{Code: code, Message: errors.CodeName(code)}
+}
+
+func invalidArgument(field string) error {
+	return status.Error(codes.InvalidArgument, "Invalid "+field)
+}
+
+func parseID(id []byte) (string, bool) {
+	s := string(id)
+	return s, s != "" && !strings.ContainsRune(s, 0)
+}
+
+func parseAddr(b []byte) (netip.Addr, bool) {
+	addr, err := netip.ParseAddr(string(b))
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr, true
+}
+
+func parseIP(ip *dpdkproto.IpAddress) (netip.Addr, bool) {
+	if ip == nil {
+		return netip.Addr{}, false
+	}
+	addr, ok := parseAddr(ip.GetAddress())
+	if !ok {
+		return netip.Addr{}, false
+	}
+	switch ip.GetIpver() {
+	case dpdkproto.IpVersion_IPV4:
+		return addr, addr.Is4()
+	case dpdkproto.IpVersion_IPV6:
+		return addr, addr.Is6()
+	default:
+		return netip.Addr{}, false
+	}
+}
+
+// parsePrefix validates a proto prefix, field is used as error prefix.
+func parsePrefix(prefix *dpdkproto.Prefix, field string) (netip.Prefix, error) {
+	addr, ok := parseIP(prefix.GetIp())
+	if !ok {
+		return netip.Prefix{}, invalidArgument(field + ".ip")
+	}
+	if int(prefix.GetLength()) > addr.BitLen() {
+		return netip.Prefix{}, invalidArgument(field + ".length")
+	}
+	return netip.PrefixFrom(addr, int(prefix.GetLength())).Masked(), nil
+}
+
+func protoIP(addr netip.Addr) *dpdkproto.IpAddress {
+	ipver := dpdkproto.IpVersion_IPV4
+	if addr.Is6() {
+		ipver = dpdkproto.IpVersion_IPV6
+	}
+	return &dpdkproto.IpAddress{Ipver: ipver, Address: []byte(addr.String())}
+}
+
+func protoPrefix(prefix netip.Prefix, underlayRoute netip.Addr) *dpdkproto.Prefix {
+	res := &dpdkproto.Prefix{
+		Ip:     protoIP(prefix.Addr()),
+		Length: uint32(prefix.Bits()),
+	}
+	if underlayRoute.IsValid() {
+		res.UnderlayRoute = []byte(underlayRoute.String())
+	}
+	return res
+}
+
+func validPort(port int32) bool {
+	return port >= -1 && port <= 0xffff
+}
+
+func validateProtocolFilter(filter *dpdkproto.ProtocolFilter) error {
+	validateRange := func(proto, dir string, lower, upper int32) error {
+		if !validPort(lower) {
+			return invalidArgument(proto + "." + dir + "_port_lower")
+		}
+		if !validPort(upper) {
+			return invalidArgument(proto + "." + dir + "_port_upper")
+		}
+		if lower > upper {
+			return invalidArgument(proto + "." + dir + "_port range")
+		}
+		return nil
+	}
+
+	switch {
+	case filter.GetTcp() != nil:
+		tcp := filter.GetTcp()
+		if err := validateRange("tcp", "src", tcp.SrcPortLower, tcp.SrcPortUpper); err != nil {
+			return err
+		}
+		return validateRange("tcp", "dst", tcp.DstPortLower, tcp.DstPortUpper)
+	case filter.GetUdp() != nil:
+		udp := filter.GetUdp()
+		if err := validateRange("udp", "src", udp.SrcPortLower, udp.SrcPortUpper); err != nil {
+			return err
+		}
+		return validateRange("udp", "dst", udp.DstPortLower, udp.DstPortUpper)
+	case filter.GetIcmp() != nil:
+		icmp := filter.GetIcmp()
+		if icmp.IcmpType < -1 || icmp.IcmpType > 0xff {
+			return invalidArgument("icmp.icmp_type")
+		}
+		if icmp.IcmpCode < -1 || icmp.IcmpCode > 0xff {
+			return invalidArgument("icmp.icmp_code")
+		}
+	}
+	return nil
+}
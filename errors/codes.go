@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package errors
+
+import "fmt"
+
+var codeNames = map[uint32]string{
+	BAD_REQUEST:     "BAD_REQUEST",
+	NOT_FOUND:       "NOT_FOUND",
+	ALREADY_EXISTS:  "ALREADY_EXISTS",
+	WRONG_TYPE:      "WRONG_TYPE",
+	BAD_IPVER:       "BAD_IPVER",
+	NO_VM:           "NO_VM",
+	NO_VNI:          "NO_VNI",
+	ITERATOR:        "ITERATOR",
+	OUT_OF_MEMORY:   "OUT_OF_MEMORY",
+	LIMIT_REACHED:   "LIMIT_REACHED",
+	ALREADY_ACTIVE:  "ALREADY_ACTIVE",
+	NOT_ACTIVE:      "NOT_ACTIVE",
+	ROLLBACK:        "ROLLBACK",
+	RTE_RULE_ADD:    "RTE_RULE_ADD",
+	RTE_RULE_DEL:    "RTE_RULE_DEL",
+	ROUTE_EXISTS:    "ROUTE_EXISTS",
+	ROUTE_NOT_FOUND: "ROUTE_NOT_FOUND",
+	ROUTE_INSERT:    "ROUTE_INSERT",
+	ROUTE_BAD_PORT:  "ROUTE_BAD_PORT",
+	ROUTE_RESET:     "ROUTE_RESET",
+	DNAT_NO_DATA:    "DNAT_NO_DATA",
+	DNAT_CREATE:     "DNAT_CREATE",
+	DNAT_EXISTS:     "DNAT_EXISTS",
+	SNAT_NO_DATA:    "SNAT_NO_DATA",
+	SNAT_CREATE:     "SNAT_CREATE",
+	SNAT_EXISTS:     "SNAT_EXISTS",
+	VNI_INIT4:       "VNI_INIT4",
+	VNI_INIT6:       "VNI_INIT6",
+	VNI_FREE4:       "VNI_FREE4",
+	VNI_FREE6:       "VNI_FREE6",
+	PORT_START:      "PORT_START",
+	PORT_STOP:       "PORT_STOP",
+	VNF_INSERT:      "VNF_INSERT",
+	VM_HANDLE:       "VM_HANDLE",
+	NO_BACKIP:       "NO_BACKIP",
+	NO_LB:           "NO_LB",
+	NO_DROP_SUPPORT: "NO_DROP_SUPPORT",
+}
+
+// CodeName returns the symbolic name of a dp-service status code, e.g. NOT_FOUND.
+// Unknown codes are returned as UNKNOWN(<code>).
+func CodeName(code uint32) string {
+	if name, ok := codeNames[code]; ok {
+		return name
+	}
+	return fmt.Sprintf("UNKNOWN(%d)", code)
+}
+
+// Category groups status codes by the part of dp-service reporting them.
+type Category string
+
+const (
+	CategoryRequest      Category = "request"
+	CategoryGeneral      Category = "general"
+	CategoryRoute        Category = "route"
+	CategoryNAT          Category = "nat"
+	CategoryVNI          Category = "vni"
+	CategoryPort         Category = "port"
+	CategoryVNF          Category = "vnf"
+	CategoryLoadBalancer Category = "loadbalancer"
+	CategoryFirewall     Category = "firewall"
+	CategoryUnknown      Category = "unknown"
+)
+
+// CategoryOf returns the category of a status code, derived from the code ranges dp-service uses.
+func CategoryOf(code uint32) Category {
+	switch {
+	case code >= 100 && code < 200:
+		return CategoryRequest
+	case code >= 200 && code < 300:
+		return CategoryGeneral
+	case code >= 300 && code < 320:
+		return CategoryRoute
+	case code >= 320 && code < 360:
+		return CategoryNAT
+	case code >= 360 && code < 380:
+		return CategoryVNI
+	case code >= 380 && code < 400:
+		return CategoryPort
+	case code >= 400 && code < 420:
+		return CategoryVNF
+	case code >= 420 && code < 440:
+		return CategoryLoadBalancer
+	case code >= 440 && code < 460:
+		return CategoryFirewall
+	default:
+		return CategoryUnknown
+	}
+}
+
+// Sentinel errors for every status code, to be used with errors.Is, e.g.
+// errors.Is(err, ErrAlreadyExists). They match any StatusError with the same code.
+var (
+	ErrBadRequest    = newSentinel(BAD_REQUEST)
+	ErrNotFound      = newSentinel(NOT_FOUND)
+	ErrAlreadyExists = newSentinel(ALREADY_EXISTS)
+	ErrWrongType     = newSentinel(WRONG_TYPE)
+	ErrBadIPVer      = newSentinel(BAD_IPVER)
+	ErrNoVM          = newSentinel(NO_VM)
+	ErrNoVNI         = newSentinel(NO_VNI)
+	ErrIterator      = newSentinel(ITERATOR)
+	ErrOutOfMemory   = newSentinel(OUT_OF_MEMORY)
+	ErrLimitReached  = newSentinel(LIMIT_REACHED)
+	ErrAlreadyActive = newSentinel(ALREADY_ACTIVE)
+	ErrNotActive     = newSentinel(NOT_ACTIVE)
+	ErrRollback      = newSentinel(ROLLBACK)
+	ErrRTERuleAdd    = newSentinel(RTE_RULE_ADD)
+	ErrRTERuleDel    = newSentinel(RTE_RULE_DEL)
+	ErrRouteExists   = newSentinel(ROUTE_EXISTS)
+	ErrRouteNotFound = newSentinel(ROUTE_NOT_FOUND)
+	ErrRouteInsert   = newSentinel(ROUTE_INSERT)
+	ErrRouteBadPort  = newSentinel(ROUTE_BAD_PORT)
+	ErrRouteReset    = newSentinel(ROUTE_RESET)
+	ErrDNATNoData    = newSentinel(DNAT_NO_DATA)
+	ErrDNATCreate    = newSentinel(DNAT_CREATE)
+	ErrDNATExists    = newSentinel(DNAT_EXISTS)
+	ErrSNATNoData    = newSentinel(SNAT_NO_DATA)
+	ErrSNATCreate    = newSentinel(SNAT_CREATE)
+	ErrSNATExists    = newSentinel(SNAT_EXISTS)
+	ErrVNIInit4      = newSentinel(VNI_INIT4)
+	ErrVNIInit6      = newSentinel(VNI_INIT6)
+	ErrVNIFree4      = newSentinel(VNI_FREE4)
+	ErrVNIFree6      = newSentinel(VNI_FREE6)
+	ErrPortStart     = newSentinel(PORT_START)
+	ErrPortStop      = newSentinel(PORT_STOP)
+	ErrVNFInsert     = newSentinel(VNF_INSERT)
+	ErrVMHandle      = newSentinel(VM_HANDLE)
+	ErrNoBackIP      = newSentinel(NO_BACKIP)
+	ErrNoLB          = newSentinel(NO_LB)
+	ErrNoDropSupport = newSentinel(NO_DROP_SUPPORT)
+)
+
+func newSentinel(code uint32) *StatusError {
+	return NewStatusError(code, CodeName(code))
+}
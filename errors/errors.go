@@ -80,6 +80,18 @@ func (s *StatusError) Error() string {
 	return fmt.Sprintf("error code %d", s.errorCode)
 }
 
+// Is reports whether target is a StatusError with the same code, which makes
+// errors.Is(err, ErrNotFound) work regardless of the message.
+func (s *StatusError) Is(target error) bool {
+	t, ok := target.(*StatusError)
+	return ok && t.errorCode == s.errorCode
+}
+
+// Category returns the category of the status code.
+func (s *StatusError) Category() Category {
+	return CategoryOf(s.errorCode)
+}
+
 func NewStatusError(errorCode uint32, message string) *StatusError {
 	return &StatusError{
 		errorCode: errorCode,
@@ -92,8 +104,8 @@ func GetError(status *dpdkproto.Status, ignoredErrors [][]uint32) error {
 	if status.Code == 0 {
 		return nil
 	}
-	if len(ignoredErrors) > 0 {
-		for _, ignoredError := range ignoredErrors[0] {
+	for _, codes := range ignoredErrors {
+		for _, ignoredError := range codes {
 			if status.Code == ignoredError {
 				return nil
 			}
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package errors
+
+import (
+	"errors"
+	"net/http"
+
+	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var grpcCodes = map[uint32]codes.Code{
+	BAD_REQUEST:     codes.InvalidArgument,
+	NOT_FOUND:       codes.NotFound,
+	ALREADY_EXISTS:  codes.AlreadyExists,
+	WRONG_TYPE:      codes.InvalidArgument,
+	BAD_IPVER:       codes.InvalidArgument,
+	NO_VM:           codes.NotFound,
+	NO_VNI:          codes.NotFound,
+	ITERATOR:        codes.Unavailable,
+	OUT_OF_MEMORY:   codes.ResourceExhausted,
+	LIMIT_REACHED:   codes.ResourceExhausted,
+	ALREADY_ACTIVE:  codes.FailedPrecondition,
+	NOT_ACTIVE:      codes.FailedPrecondition,
+	ROLLBACK:        codes.Unavailable,
+	RTE_RULE_ADD:    codes.Unavailable,
+	RTE_RULE_DEL:    codes.Unavailable,
+	ROUTE_EXISTS:    codes.AlreadyExists,
+	ROUTE_NOT_FOUND: codes.NotFound,
+	ROUTE_INSERT:    codes.Internal,
+	ROUTE_BAD_PORT:  codes.InvalidArgument,
+	ROUTE_RESET:     codes.Internal,
+	DNAT_NO_DATA:    codes.NotFound,
+	DNAT_CREATE:     codes.Internal,
+	DNAT_EXISTS:     codes.AlreadyExists,
+	SNAT_NO_DATA:    codes.NotFound,
+	SNAT_CREATE:     codes.Internal,
+	SNAT_EXISTS:     codes.AlreadyExists,
+	VNI_INIT4:       codes.Internal,
+	VNI_INIT6:       codes.Internal,
+	VNI_FREE4:       codes.Internal,
+	VNI_FREE6:       codes.Internal,
+	PORT_START:      codes.Internal,
+	PORT_STOP:       codes.Internal,
+	VNF_INSERT:      codes.Internal,
+	VM_HANDLE:       codes.Internal,
+	NO_BACKIP:       codes.NotFound,
+	NO_LB:           codes.NotFound,
+	NO_DROP_SUPPORT: codes.Unimplemented,
+}
+
+// GRPCCode returns the gRPC code matching a dp-service status code, codes.Unknown for unknown codes.
+func GRPCCode(code uint32) codes.Code {
+	if c, ok := grpcCodes[code]; ok {
+		return c
+	}
+	return codes.Unknown
+}
+
+// GRPCStatus converts the error into a gRPC status with the matching code.
+// The original dp-service status is attached as a detail, so FromGRPCStatus
+// can restore it on the other side. Returning a StatusError from a gRPC
+// handler makes gRPC send this status.
+func (s *StatusError) GRPCStatus() *status.Status {
+	st := status.New(GRPCCode(s.errorCode), s.Error())
+	withDetails, err := st.WithDetails(&dpdkproto.Status{Code: s.errorCode, Message: s.message})
+	if err != nil {
+		return st
+	}
+	return withDetails
+}
+
+// HTTPStatus returns the HTTP status code matching the error, following the
+// mapping of gRPC codes used by gRPC gateways.
+func (s *StatusError) HTTPStatus() int {
+	switch GRPCCode(s.errorCode) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.FailedPrecondition:
+		return http.StatusBadRequest
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// FromGRPCStatus restores the StatusError attached to a gRPC status by GRPCStatus.
+// It returns false if the status carries no dp-service status.
+func FromGRPCStatus(s *status.Status) (*StatusError, bool) {
+	for _, detail := range s.Details() {
+		if st, ok := detail.(*dpdkproto.Status); ok && st.GetCode() != 0 {
+			return NewStatusError(st.GetCode(), st.GetMessage()), true
+		}
+	}
+	return nil, false
+}
+
+// FromGRPCError returns the StatusError carried by a gRPC error, e.g. one
+// returned by a service proxying dp-service. Other errors are returned as is.
+func FromGRPCError(err error) error {
+	statusError := &StatusError{}
+	if err == nil || errors.As(err, &statusError) {
+		return err
+	}
+	if s, ok := status.FromError(err); ok {
+		if statusError, ok := FromGRPCStatus(s); ok {
+			return statusError
+		}
+	}
+	return err
+}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package errors
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// retryableCodes are dp-service status codes caused by temporary conditions,
+// e.g. resource exhaustion or a failed hardware rule update that was rolled back.
+var retryableCodes = map[uint32]bool{
+	ITERATOR:      true,
+	OUT_OF_MEMORY: true,
+	ROLLBACK:      true,
+	RTE_RULE_ADD:  true,
+	RTE_RULE_DEL:  true,
+}
+
+// IsRetryableCode reports whether a dp-service status code is worth retrying.
+func IsRetryableCode(code uint32) bool {
+	return retryableCodes[code]
+}
+
+// IsRetryable reports whether the call that returned err may succeed when repeated.
+// This is the case for StatusErrors with a retryable code and for the gRPC
+// transport errors Unavailable and DeadlineExceeded. All other errors, e.g.
+// ALREADY_EXISTS, BAD_IPVER or NOT_FOUND, are permanent. gRPC errors carrying
+// a dp-service status, see StatusError.GRPCStatus, are classified by that status.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	statusError := &StatusError{}
+	if errors.As(FromGRPCError(err), &statusError) {
+		return IsRetryableCode(statusError.ErrorCode())
+	}
+
+	if s, ok := status.FromError(err); ok {
+		switch s.Code() {
+		case codes.Unavailable, codes.DeadlineExceeded:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,270 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package exporter exports the objects held by a dp-service node as Prometheus gauges.
+// The node is walked periodically with snapshot.Snapshot, scrapes are served from the last walk.
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/client"
+	"github.com/ironcore-dev/dpservice-go/snapshot"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "dpservice"
+
+var (
+	interfacesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "interfaces"),
+		"Number of interfaces per VNI.",
+		[]string{"vni"}, nil,
+	)
+	routesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "routes"),
+		"Number of routes per VNI.",
+		[]string{"vni"}, nil,
+	)
+	prefixesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "prefixes"),
+		"Number of prefixes per interface.",
+		[]string{"interface"}, nil,
+	)
+	loadBalancerPrefixesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "loadbalancer_prefixes"),
+		"Number of loadbalancer prefixes per interface.",
+		[]string{"interface"}, nil,
+	)
+	firewallRulesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "firewall_rules"),
+		"Number of firewall rules per interface.",
+		[]string{"interface"}, nil,
+	)
+	virtualIPsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "virtual_ips"),
+		"Number of interfaces with a virtual IP.",
+		nil, nil,
+	)
+	natsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "nats"),
+		"Number of interfaces with a NAT per NAT IP.",
+		[]string{"nat_ip"}, nil,
+	)
+	neighborNatsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "neighbor_nats"),
+		"Number of neighbor NATs per NAT IP.",
+		[]string{"nat_ip"}, nil,
+	)
+	loadBalancerTargetsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "loadbalancer_targets"),
+		"Number of targets per loadbalancer.",
+		[]string{"loadbalancer"}, nil,
+	)
+	captureActiveDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "capture_active"),
+		"Whether packet capturing is active.",
+		nil, nil,
+	)
+	refreshSuccessDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "exporter", "last_refresh_success"),
+		"Whether the last walk of dp-service succeeded.",
+		nil, nil,
+	)
+	refreshTimestampDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "exporter", "last_refresh_timestamp_seconds"),
+		"Time of the last successful walk of dp-service.",
+		nil, nil,
+	)
+)
+
+type options struct {
+	snapshotOptions []snapshot.Option
+	errorHandler    func(error)
+}
+
+// Option configures a Collector.
+type Option func(*options)
+
+// WithLoadBalancers exports the targets of the given load balancers.
+// dp-service cannot list load balancers, so only the ones given here are exported.
+func WithLoadBalancers(ids ...string) Option {
+	return func(o *options) {
+		o.snapshotOptions = append(o.snapshotOptions, snapshot.WithLoadBalancers(ids...))
+	}
+}
+
+// WithNatIPs exports the neighbor NATs of the given NAT IPs, in addition to the ones of the NAT IPs used by interfaces.
+func WithNatIPs(ips ...netip.Addr) Option {
+	return func(o *options) {
+		o.snapshotOptions = append(o.snapshotOptions, snapshot.WithNatIPs(ips...))
+	}
+}
+
+// WithErrorHandler is called when a walk of dp-service fails in Run.
+func WithErrorHandler(handler func(error)) Option {
+	return func(o *options) {
+		o.errorHandler = handler
+	}
+}
+
+// Collector is a prometheus.Collector of the objects held by a dp-service node.
+// The gauges are updated by Refresh or Run and are empty until the first successful walk.
+type Collector struct {
+	c client.Client
+	o options
+
+	mu            sync.RWMutex
+	snap          *snapshot.NodeSnapshot
+	captureActive bool
+	success       bool
+	refreshed     time.Time
+}
+
+var _ prometheus.Collector = &Collector{}
+
+// New returns a Collector walking dp-service with c.
+func New(c client.Client, opts ...Option) *Collector {
+	col := &Collector{c: c}
+	for _, opt := range opts {
+		opt(&col.o)
+	}
+	return col
+}
+
+// Refresh walks dp-service once. If it fails, the gauges keep the values of the last successful walk.
+func (col *Collector) Refresh(ctx context.Context) error {
+	snap, capture, err := col.walk(ctx)
+
+	col.mu.Lock()
+	defer col.mu.Unlock()
+	col.success = err == nil
+	if err != nil {
+		return err
+	}
+	col.snap = snap
+	col.captureActive = capture.Spec.OperationStatus
+	col.refreshed = time.Now()
+	return nil
+}
+
+func (col *Collector) walk(ctx context.Context) (*snapshot.NodeSnapshot, *api.CaptureStatus, error) {
+	snap, err := snapshot.Snapshot(ctx, col.c, col.o.snapshotOptions...)
+	if err != nil {
+		return nil, nil, err
+	}
+	capture, err := col.c.CaptureStatus(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting capture status: %w", err)
+	}
+	return snap, capture, nil
+}
+
+// Run refreshes immediately and then every interval until ctx is done. Each walk has to finish within interval.
+func (col *Collector) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		refreshCtx, cancel := context.WithTimeout(ctx, interval)
+		err := col.Refresh(refreshCtx)
+		cancel()
+		if err != nil && col.o.errorHandler != nil && ctx.Err() == nil {
+			col.o.errorHandler(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (col *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- interfacesDesc
+	ch <- routesDesc
+	ch <- prefixesDesc
+	ch <- loadBalancerPrefixesDesc
+	ch <- firewallRulesDesc
+	ch <- virtualIPsDesc
+	ch <- natsDesc
+	ch <- neighborNatsDesc
+	ch <- loadBalancerTargetsDesc
+	ch <- captureActiveDesc
+	ch <- refreshSuccessDesc
+	ch <- refreshTimestampDesc
+}
+
+func (col *Collector) Collect(ch chan<- prometheus.Metric) {
+	col.mu.RLock()
+	defer col.mu.RUnlock()
+
+	ch <- prometheus.MustNewConstMetric(refreshSuccessDesc, prometheus.GaugeValue, boolValue(col.success))
+	if col.snap == nil {
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(refreshTimestampDesc, prometheus.GaugeValue, float64(col.refreshed.UnixNano())/1e9)
+	ch <- prometheus.MustNewConstMetric(captureActiveDesc, prometheus.GaugeValue, boolValue(col.captureActive))
+
+	interfaces := map[string]int{}
+	nats := map[string]int{}
+	virtualIPs := 0
+	for _, iface := range col.snap.Interfaces {
+		interfaces[strconv.FormatUint(uint64(iface.Spec.VNI), 10)]++
+		ch <- prometheus.MustNewConstMetric(prefixesDesc, prometheus.GaugeValue, float64(len(iface.Prefixes)), iface.ID)
+		ch <- prometheus.MustNewConstMetric(loadBalancerPrefixesDesc, prometheus.GaugeValue, float64(len(iface.LoadBalancerPrefixes)), iface.ID)
+		ch <- prometheus.MustNewConstMetric(firewallRulesDesc, prometheus.GaugeValue, float64(len(iface.FirewallRules)), iface.ID)
+		if iface.VirtualIP != nil {
+			virtualIPs++
+		}
+		if iface.Nat != nil && iface.Nat.NatIP != nil {
+			nats[iface.Nat.NatIP.String()]++
+		}
+	}
+	ch <- prometheus.MustNewConstMetric(virtualIPsDesc, prometheus.GaugeValue, float64(virtualIPs))
+	collectCounts(ch, interfacesDesc, interfaces)
+	collectCounts(ch, natsDesc, nats)
+
+	// VNIs without routes are not in the snapshot, they are reported with 0 routes.
+	routes := map[string]int{}
+	for vni := range interfaces {
+		routes[vni] = 0
+	}
+	for _, lb := range col.snap.LoadBalancers {
+		routes[strconv.FormatUint(uint64(lb.Spec.VNI), 10)] = 0
+	}
+	for vni, vniRoutes := range col.snap.Routes {
+		routes[strconv.FormatUint(uint64(vni), 10)] = len(vniRoutes)
+	}
+	collectCounts(ch, routesDesc, routes)
+
+	neighborNats := map[string]int{}
+	for _, nat := range col.snap.NeighborNats {
+		neighborNats[nat.NatIP.String()]++
+	}
+	collectCounts(ch, neighborNatsDesc, neighborNats)
+
+	for _, lb := range col.snap.LoadBalancers {
+		ch <- prometheus.MustNewConstMetric(loadBalancerTargetsDesc, prometheus.GaugeValue, float64(len(lb.Targets)), lb.ID)
+	}
+}
+
+func collectCounts(ch chan<- prometheus.Metric, desc *prometheus.Desc, counts map[string]int) {
+	for label, count := range counts {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(count), label)
+	}
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter
+
+import (
+	"context"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/client"
+	"github.com/ironcore-dev/dpservice-go/errors"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func addr(s string) *netip.Addr {
+	a := netip.MustParseAddr(s)
+	return &a
+}
+
+func prefix(s string) *netip.Prefix {
+	p := netip.MustParsePrefix(s)
+	return &p
+}
+
+// statusClient lists firewall rules with a non-zero status, like dp-service does on failures.
+type statusClient struct {
+	client.Client
+}
+
+func (c statusClient) ListFirewallRules(context.Context, string, ...client.CallOption) (*api.FirewallRuleList, error) {
+	return &api.FirewallRuleList{Status: api.Status{Code: errors.NO_VM, Message: "listing failed"}}, nil
+}
+
+var _ = Describe("exporter", func() {
+	var c client.Client
+
+	BeforeEach(func(ctx SpecContext) {
+		c = newFakeClient(ctx)
+
+		_, err := client.AttachVM(ctx, c, client.VMNetworkSpec{
+			ID:        "vm1",
+			Interface: api.InterfaceSpec{VNI: 100, Device: "net_tap2", IPv4: addr("10.200.1.4"), IPv6: addr("2000:200:1::4")},
+			Prefixes:  []netip.Prefix{*prefix("10.20.30.0/24"), *prefix("10.20.31.0/24")},
+			Nat:       &api.NatSpec{NatIP: addr("10.20.30.40"), MinPort: 100, MaxPort: 200},
+			FirewallRules: []api.FirewallRuleSpec{{
+				RuleID:            "fr1",
+				TrafficDirection:  "Ingress",
+				FirewallAction:    "Accept",
+				Priority:          1000,
+				SourcePrefix:      prefix("0.0.0.0/0"),
+				DestinationPrefix: prefix("10.200.1.4/32"),
+			}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = client.AttachVM(ctx, c, client.VMNetworkSpec{
+			ID:        "vm2",
+			Interface: api.InterfaceSpec{VNI: 200, Device: "net_tap3", IPv4: addr("10.200.1.5"), IPv6: addr("2000:200:1::5")},
+			VirtualIP: addr("20.20.0.1"),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.CreateRoute(ctx, &api.Route{
+			RouteMeta: api.RouteMeta{VNI: 100},
+			Spec:      api.RouteSpec{Prefix: prefix("10.100.3.0/24"), NextHop: &api.RouteNextHop{IP: addr("fc00:2::64:0:1")}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.CreateLoadBalancer(ctx, &api.LoadBalancer{
+			LoadBalancerMeta: api.LoadBalancerMeta{ID: "lb1"},
+			Spec:             api.LoadBalancerSpec{VNI: 100, LbVipIP: addr("10.20.40.50"), Lbports: []api.LBPort{{Protocol: 6, Port: 443}}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.CreateLoadBalancerTarget(ctx, &api.LoadBalancerTarget{
+			LoadBalancerTargetMeta: api.LoadBalancerTargetMeta{LoadbalancerID: "lb1"},
+			Spec:                   api.LoadBalancerTargetSpec{TargetIP: addr("ff80::5")},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.CreateNeighborNat(ctx, &api.NeighborNat{
+			NeighborNatMeta: api.NeighborNatMeta{NatIP: addr("10.20.30.40")},
+			Spec:            api.NeighborNatSpec{Vni: 100, MinPort: 300, MaxPort: 400, UnderlayRoute: addr("ff80::1")},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should export the number of objects of the node", func(ctx SpecContext) {
+		col := New(c, WithLoadBalancers("lb1"))
+		Expect(col.Refresh(ctx)).To(Succeed())
+
+		Expect(testutil.CollectAndCompare(col, strings.NewReader(`
+# HELP dpservice_capture_active Whether packet capturing is active.
+# TYPE dpservice_capture_active gauge
+dpservice_capture_active 0
+# HELP dpservice_firewall_rules Number of firewall rules per interface.
+# TYPE dpservice_firewall_rules gauge
+dpservice_firewall_rules{interface="vm1"} 1
+dpservice_firewall_rules{interface="vm2"} 0
+# HELP dpservice_interfaces Number of interfaces per VNI.
+# TYPE dpservice_interfaces gauge
+dpservice_interfaces{vni="100"} 1
+dpservice_interfaces{vni="200"} 1
+# HELP dpservice_loadbalancer_targets Number of targets per loadbalancer.
+# TYPE dpservice_loadbalancer_targets gauge
+dpservice_loadbalancer_targets{loadbalancer="lb1"} 1
+# HELP dpservice_nats Number of interfaces with a NAT per NAT IP.
+# TYPE dpservice_nats gauge
+dpservice_nats{nat_ip="10.20.30.40"} 1
+# HELP dpservice_neighbor_nats Number of neighbor NATs per NAT IP.
+# TYPE dpservice_neighbor_nats gauge
+dpservice_neighbor_nats{nat_ip="10.20.30.40"} 1
+# HELP dpservice_prefixes Number of prefixes per interface.
+# TYPE dpservice_prefixes gauge
+dpservice_prefixes{interface="vm1"} 2
+dpservice_prefixes{interface="vm2"} 0
+# HELP dpservice_routes Number of routes per VNI.
+# TYPE dpservice_routes gauge
+dpservice_routes{vni="100"} 1
+dpservice_routes{vni="200"} 0
+# HELP dpservice_virtual_ips Number of interfaces with a virtual IP.
+# TYPE dpservice_virtual_ips gauge
+dpservice_virtual_ips 1
+# HELP dpservice_exporter_last_refresh_success Whether the last walk of dp-service succeeded.
+# TYPE dpservice_exporter_last_refresh_success gauge
+dpservice_exporter_last_refresh_success 1
+`), "dpservice_capture_active", "dpservice_firewall_rules", "dpservice_interfaces", "dpservice_loadbalancer_targets",
+			"dpservice_nats", "dpservice_neighbor_nats", "dpservice_prefixes", "dpservice_routes", "dpservice_virtual_ips",
+			"dpservice_exporter_last_refresh_success")).To(Succeed())
+	})
+
+	It("should report active captures", func(ctx SpecContext) {
+		_, err := c.CaptureStart(ctx, &api.CaptureStart{
+			CaptureStartMeta: api.CaptureStartMeta{
+				Config: &api.CaptureConfig{SinkNodeIP: addr("fc00:2::64:0:1"), UdpSrcPort: 500, UdpDstPort: 1000},
+			},
+			Spec: api.CaptureStartSpec{Interfaces: []api.CaptureInterface{{InterfaceType: "vf", InterfaceInfo: "net_tap2"}}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		col := New(c)
+		Expect(col.Refresh(ctx)).To(Succeed())
+		Expect(testutil.CollectAndCompare(col, strings.NewReader(`
+# HELP dpservice_capture_active Whether packet capturing is active.
+# TYPE dpservice_capture_active gauge
+dpservice_capture_active 1
+`), "dpservice_capture_active")).To(Succeed())
+	})
+
+	It("should keep the last values when a walk fails", func(ctx SpecContext) {
+		errs := make(chan error, 1)
+		col := New(c, WithLoadBalancers("lb1"), WithErrorHandler(func(err error) { errs <- err }))
+		Expect(col.Refresh(ctx)).To(Succeed())
+
+		_, err := c.DeleteLoadBalancer(ctx, "lb1")
+		Expect(err).NotTo(HaveOccurred())
+		runCtx, cancel := context.WithCancel(ctx)
+		DeferCleanup(cancel)
+		go col.Run(runCtx, time.Hour)
+
+		Eventually(errs).Should(Receive(MatchError(ContainSubstring("error getting loadbalancer lb1"))))
+		Expect(testutil.CollectAndCompare(col, strings.NewReader(`
+# HELP dpservice_exporter_last_refresh_success Whether the last walk of dp-service succeeded.
+# TYPE dpservice_exporter_last_refresh_success gauge
+dpservice_exporter_last_refresh_success 0
+`), "dpservice_exporter_last_refresh_success")).To(Succeed())
+		Expect(testutil.CollectAndCount(col, "dpservice_interfaces")).To(Equal(2))
+	})
+	It("should not publish a walk with a failed list", func(ctx SpecContext) {
+		col := New(c)
+		Expect(col.Refresh(ctx)).To(Succeed())
+
+		col.c = statusClient{Client: c}
+		Expect(col.Refresh(ctx)).To(MatchError(ContainSubstring("error listing firewall rules of interface vm1")))
+		Expect(testutil.CollectAndCompare(col, strings.NewReader(`
+# HELP dpservice_exporter_last_refresh_success Whether the last walk of dp-service succeeded.
+# TYPE dpservice_exporter_last_refresh_success gauge
+dpservice_exporter_last_refresh_success 0
+# HELP dpservice_firewall_rules Number of firewall rules per interface.
+# TYPE dpservice_firewall_rules gauge
+dpservice_firewall_rules{interface="vm1"} 1
+dpservice_firewall_rules{interface="vm2"} 0
+`), "dpservice_exporter_last_refresh_success", "dpservice_firewall_rules")).To(Succeed())
+	})
+})
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ironcore-dev/dpservice-go/client"
+	"github.com/ironcore-dev/dpservice-go/dpservicetest"
+	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestExporter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Exporter Suite")
+}
+
+// newFakeClient returns an initialized client of a fake dp-service which is stopped after the current spec.
+func newFakeClient(ctx context.Context) client.Client {
+	server := dpservicetest.NewServer()
+	server.Start()
+	DeferCleanup(server.Close)
+
+	conn, err := server.Dial(ctx)
+	Expect(err).NotTo(HaveOccurred())
+	DeferCleanup(conn.Close)
+
+	c := client.NewClient(dpdkproto.NewDPDKironcoreClient(conn))
+	_, err = c.Initialize(ctx)
+	Expect(err).NotTo(HaveOccurred())
+	return c
+}
@@ -0,0 +1,312 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"context"
+	"net/netip"
+	"time"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/client"
+)
+
+type instrumentedClient struct {
+	client.Client
+	m *Metrics
+}
+
+func (c *instrumentedClient) GetLoadBalancer(ctx context.Context, id string, opts ...client.CallOption) (*api.LoadBalancer, error) {
+	start := time.Now()
+	res, err := c.Client.GetLoadBalancer(ctx, id, opts...)
+	observeClient(c.m, "GetLoadBalancer", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) CreateLoadBalancer(ctx context.Context, lb *api.LoadBalancer, opts ...client.CallOption) (*api.LoadBalancer, error) {
+	start := time.Now()
+	res, err := c.Client.CreateLoadBalancer(ctx, lb, opts...)
+	observeClient(c.m, "CreateLoadBalancer", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) DeleteLoadBalancer(ctx context.Context, id string, opts ...client.CallOption) (*api.LoadBalancer, error) {
+	start := time.Now()
+	res, err := c.Client.DeleteLoadBalancer(ctx, id, opts...)
+	observeClient(c.m, "DeleteLoadBalancer", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) ListLoadBalancerPrefixes(ctx context.Context, interfaceID string, opts ...client.CallOption) (*api.PrefixList, error) {
+	start := time.Now()
+	res, err := c.Client.ListLoadBalancerPrefixes(ctx, interfaceID, opts...)
+	observeClient(c.m, "ListLoadBalancerPrefixes", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) CreateLoadBalancerPrefix(ctx context.Context, prefix *api.LoadBalancerPrefix, opts ...client.CallOption) (*api.LoadBalancerPrefix, error) {
+	start := time.Now()
+	res, err := c.Client.CreateLoadBalancerPrefix(ctx, prefix, opts...)
+	observeClient(c.m, "CreateLoadBalancerPrefix", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) DeleteLoadBalancerPrefix(ctx context.Context, interfaceID string, prefix *netip.Prefix, opts ...client.CallOption) (*api.LoadBalancerPrefix, error) {
+	start := time.Now()
+	res, err := c.Client.DeleteLoadBalancerPrefix(ctx, interfaceID, prefix, opts...)
+	observeClient(c.m, "DeleteLoadBalancerPrefix", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) ListLoadBalancerTargets(ctx context.Context, interfaceID string, opts ...client.CallOption) (*api.LoadBalancerTargetList, error) {
+	start := time.Now()
+	res, err := c.Client.ListLoadBalancerTargets(ctx, interfaceID, opts...)
+	observeClient(c.m, "ListLoadBalancerTargets", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) CreateLoadBalancerTarget(ctx context.Context, lbtarget *api.LoadBalancerTarget, opts ...client.CallOption) (*api.LoadBalancerTarget, error) {
+	start := time.Now()
+	res, err := c.Client.CreateLoadBalancerTarget(ctx, lbtarget, opts...)
+	observeClient(c.m, "CreateLoadBalancerTarget", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) DeleteLoadBalancerTarget(ctx context.Context, id string, targetIP *netip.Addr, opts ...client.CallOption) (*api.LoadBalancerTarget, error) {
+	start := time.Now()
+	res, err := c.Client.DeleteLoadBalancerTarget(ctx, id, targetIP, opts...)
+	observeClient(c.m, "DeleteLoadBalancerTarget", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) GetInterface(ctx context.Context, id string, opts ...client.CallOption) (*api.Interface, error) {
+	start := time.Now()
+	res, err := c.Client.GetInterface(ctx, id, opts...)
+	observeClient(c.m, "GetInterface", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) ListInterfaces(ctx context.Context, opts ...client.CallOption) (*api.InterfaceList, error) {
+	start := time.Now()
+	res, err := c.Client.ListInterfaces(ctx, opts...)
+	observeClient(c.m, "ListInterfaces", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) CreateInterface(ctx context.Context, iface *api.Interface, opts ...client.CallOption) (*api.Interface, error) {
+	start := time.Now()
+	res, err := c.Client.CreateInterface(ctx, iface, opts...)
+	observeClient(c.m, "CreateInterface", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) DeleteInterface(ctx context.Context, id string, opts ...client.CallOption) (*api.Interface, error) {
+	start := time.Now()
+	res, err := c.Client.DeleteInterface(ctx, id, opts...)
+	observeClient(c.m, "DeleteInterface", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) GetVirtualIP(ctx context.Context, interfaceID string, opts ...client.CallOption) (*api.VirtualIP, error) {
+	start := time.Now()
+	res, err := c.Client.GetVirtualIP(ctx, interfaceID, opts...)
+	observeClient(c.m, "GetVirtualIP", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) CreateVirtualIP(ctx context.Context, virtualIP *api.VirtualIP, opts ...client.CallOption) (*api.VirtualIP, error) {
+	start := time.Now()
+	res, err := c.Client.CreateVirtualIP(ctx, virtualIP, opts...)
+	observeClient(c.m, "CreateVirtualIP", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) DeleteVirtualIP(ctx context.Context, interfaceID string, opts ...client.CallOption) (*api.VirtualIP, error) {
+	start := time.Now()
+	res, err := c.Client.DeleteVirtualIP(ctx, interfaceID, opts...)
+	observeClient(c.m, "DeleteVirtualIP", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) ListPrefixes(ctx context.Context, interfaceID string, opts ...client.CallOption) (*api.PrefixList, error) {
+	start := time.Now()
+	res, err := c.Client.ListPrefixes(ctx, interfaceID, opts...)
+	observeClient(c.m, "ListPrefixes", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) CreatePrefix(ctx context.Context, prefix *api.Prefix, opts ...client.CallOption) (*api.Prefix, error) {
+	start := time.Now()
+	res, err := c.Client.CreatePrefix(ctx, prefix, opts...)
+	observeClient(c.m, "CreatePrefix", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) DeletePrefix(ctx context.Context, interfaceID string, prefix *netip.Prefix, opts ...client.CallOption) (*api.Prefix, error) {
+	start := time.Now()
+	res, err := c.Client.DeletePrefix(ctx, interfaceID, prefix, opts...)
+	observeClient(c.m, "DeletePrefix", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) ListRoutes(ctx context.Context, vni uint32, opts ...client.CallOption) (*api.RouteList, error) {
+	start := time.Now()
+	res, err := c.Client.ListRoutes(ctx, vni, opts...)
+	observeClient(c.m, "ListRoutes", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) CreateRoute(ctx context.Context, route *api.Route, opts ...client.CallOption) (*api.Route, error) {
+	start := time.Now()
+	res, err := c.Client.CreateRoute(ctx, route, opts...)
+	observeClient(c.m, "CreateRoute", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) DeleteRoute(ctx context.Context, vni uint32, prefix *netip.Prefix, opts ...client.CallOption) (*api.Route, error) {
+	start := time.Now()
+	res, err := c.Client.DeleteRoute(ctx, vni, prefix, opts...)
+	observeClient(c.m, "DeleteRoute", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) GetNat(ctx context.Context, interfaceID string, opts ...client.CallOption) (*api.Nat, error) {
+	start := time.Now()
+	res, err := c.Client.GetNat(ctx, interfaceID, opts...)
+	observeClient(c.m, "GetNat", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) CreateNat(ctx context.Context, nat *api.Nat, opts ...client.CallOption) (*api.Nat, error) {
+	start := time.Now()
+	res, err := c.Client.CreateNat(ctx, nat, opts...)
+	observeClient(c.m, "CreateNat", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) DeleteNat(ctx context.Context, interfaceID string, opts ...client.CallOption) (*api.Nat, error) {
+	start := time.Now()
+	res, err := c.Client.DeleteNat(ctx, interfaceID, opts...)
+	observeClient(c.m, "DeleteNat", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) ListLocalNats(ctx context.Context, natIP *netip.Addr, opts ...client.CallOption) (*api.NatList, error) {
+	start := time.Now()
+	res, err := c.Client.ListLocalNats(ctx, natIP, opts...)
+	observeClient(c.m, "ListLocalNats", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) CreateNeighborNat(ctx context.Context, nat *api.NeighborNat, opts ...client.CallOption) (*api.NeighborNat, error) {
+	start := time.Now()
+	res, err := c.Client.CreateNeighborNat(ctx, nat, opts...)
+	observeClient(c.m, "CreateNeighborNat", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) ListNats(ctx context.Context, natIP *netip.Addr, natType string, opts ...client.CallOption) (*api.NatList, error) {
+	start := time.Now()
+	res, err := c.Client.ListNats(ctx, natIP, natType, opts...)
+	observeClient(c.m, "ListNats", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) DeleteNeighborNat(ctx context.Context, neigbhorNat *api.NeighborNat, opts ...client.CallOption) (*api.NeighborNat, error) {
+	start := time.Now()
+	res, err := c.Client.DeleteNeighborNat(ctx, neigbhorNat, opts...)
+	observeClient(c.m, "DeleteNeighborNat", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) ListNeighborNats(ctx context.Context, natIP *netip.Addr, opts ...client.CallOption) (*api.NatList, error) {
+	start := time.Now()
+	res, err := c.Client.ListNeighborNats(ctx, natIP, opts...)
+	observeClient(c.m, "ListNeighborNats", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) ListFirewallRules(ctx context.Context, interfaceID string, opts ...client.CallOption) (*api.FirewallRuleList, error) {
+	start := time.Now()
+	res, err := c.Client.ListFirewallRules(ctx, interfaceID, opts...)
+	observeClient(c.m, "ListFirewallRules", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) CreateFirewallRule(ctx context.Context, fwRule *api.FirewallRule, opts ...client.CallOption) (*api.FirewallRule, error) {
+	start := time.Now()
+	res, err := c.Client.CreateFirewallRule(ctx, fwRule, opts...)
+	observeClient(c.m, "CreateFirewallRule", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) GetFirewallRule(ctx context.Context, interfaceID string, ruleID string, opts ...client.CallOption) (*api.FirewallRule, error) {
+	start := time.Now()
+	res, err := c.Client.GetFirewallRule(ctx, interfaceID, ruleID, opts...)
+	observeClient(c.m, "GetFirewallRule", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) DeleteFirewallRule(ctx context.Context, interfaceID string, ruleID string, opts ...client.CallOption) (*api.FirewallRule, error) {
+	start := time.Now()
+	res, err := c.Client.DeleteFirewallRule(ctx, interfaceID, ruleID, opts...)
+	observeClient(c.m, "DeleteFirewallRule", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) CheckInitialized(ctx context.Context, opts ...client.CallOption) (*api.Initialized, error) {
+	start := time.Now()
+	res, err := c.Client.CheckInitialized(ctx, opts...)
+	observeClient(c.m, "CheckInitialized", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) Initialize(ctx context.Context, opts ...client.CallOption) (*api.Initialized, error) {
+	start := time.Now()
+	res, err := c.Client.Initialize(ctx, opts...)
+	observeClient(c.m, "Initialize", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) GetVni(ctx context.Context, vni uint32, vniType uint8, opts ...client.CallOption) (*api.Vni, error) {
+	start := time.Now()
+	res, err := c.Client.GetVni(ctx, vni, vniType, opts...)
+	observeClient(c.m, "GetVni", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) ResetVni(ctx context.Context, vni uint32, vniType uint8, opts ...client.CallOption) (*api.Vni, error) {
+	start := time.Now()
+	res, err := c.Client.ResetVni(ctx, vni, vniType, opts...)
+	observeClient(c.m, "ResetVni", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) GetVersion(ctx context.Context, version *api.Version, opts ...client.CallOption) (*api.Version, error) {
+	start := time.Now()
+	res, err := c.Client.GetVersion(ctx, version, opts...)
+	observeClient(c.m, "GetVersion", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) CaptureStart(ctx context.Context, capture *api.CaptureStart, opts ...client.CallOption) (*api.CaptureStart, error) {
+	start := time.Now()
+	res, err := c.Client.CaptureStart(ctx, capture, opts...)
+	observeClient(c.m, "CaptureStart", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) CaptureStop(ctx context.Context, opts ...client.CallOption) (*api.CaptureStop, error) {
+	start := time.Now()
+	res, err := c.Client.CaptureStop(ctx, opts...)
+	observeClient(c.m, "CaptureStop", start, res, err)
+	return res, err
+}
+
+func (c *instrumentedClient) CaptureStatus(ctx context.Context, opts ...client.CallOption) (*api.CaptureStatus, error) {
+	start := time.Now()
+	res, err := c.Client.CaptureStatus(ctx, opts...)
+	observeClient(c.m, "CaptureStatus", start, res, err)
+	return res, err
+}
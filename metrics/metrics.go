@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package metrics exports Prometheus metrics of the calls to dp-service. Besides request counts,
+// latencies and transport errors it counts the status codes dp-service reports inside
+// successful gRPC responses, which generic gRPC metrics do not see.
+package metrics
+
+import (
+	"context"
+	stderrors "errors"
+	"path"
+	"time"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/client"
+	"github.com/ironcore-dev/dpservice-go/errors"
+	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	namespace = "dpservice"
+	subsystem = "client"
+)
+
+// Metrics holds the metrics of the calls to dp-service. It is a prometheus.Collector
+// and has to be registered, e.g. with prometheus.MustRegister.
+type Metrics struct {
+	requests        *prometheus.CounterVec
+	duration        *prometheus.HistogramVec
+	transportErrors *prometheus.CounterVec
+	statusCodes     *prometheus.CounterVec
+}
+
+var _ prometheus.Collector = &Metrics{}
+
+// New returns Metrics with the default histogram buckets.
+func New() *Metrics {
+	return &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "requests_total",
+			Help:      "Number of calls to dp-service.",
+		}, []string{"method"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "request_duration_seconds",
+			Help:      "Duration of calls to dp-service.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+		transportErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "transport_errors_total",
+			Help:      "Number of calls to dp-service failing with a gRPC error.",
+		}, []string{"method", "code"}),
+		statusCodes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "status_codes_total",
+			Help:      "Number of non-zero status codes returned by dp-service.",
+		}, []string{"method", "status"}),
+	}
+}
+
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.requests.Describe(ch)
+	m.duration.Describe(ch)
+	m.transportErrors.Describe(ch)
+	m.statusCodes.Describe(ch)
+}
+
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.requests.Collect(ch)
+	m.duration.Collect(ch)
+	m.transportErrors.Collect(ch)
+	m.statusCodes.Collect(ch)
+}
+
+// UnaryClientInterceptor records every gRPC call, labelled with the gRPC method name, e.g. CreateVip.
+// It can be passed to client.Dial with client.WithDialOptions(grpc.WithChainUnaryInterceptor(...)).
+func (m *Metrics) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		var code uint32
+		if res, ok := reply.(interface{ GetStatus() *dpdkproto.Status }); ok && err == nil {
+			code = res.GetStatus().GetCode()
+		}
+		m.observe(path.Base(method), start, err, code)
+		return err
+	}
+}
+
+// observe records a call. Errors not caused by gRPC, e.g. validation errors, are only counted as requests.
+func (m *Metrics) observe(method string, start time.Time, err error, code uint32) {
+	m.requests.WithLabelValues(method).Inc()
+	m.duration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+
+	statusErr := &errors.StatusError{}
+	switch {
+	case stderrors.As(err, &statusErr):
+		code = statusErr.ErrorCode()
+	case err != nil:
+		if s, ok := status.FromError(err); ok {
+			m.transportErrors.WithLabelValues(method, s.Code().String()).Inc()
+		}
+	}
+	if code != 0 {
+		m.statusCodes.WithLabelValues(method, errors.CodeName(code)).Inc()
+	}
+}
+
+// observeClient records a call of the Client returned by WrapClient. Status codes ignored
+// with client.IgnoreErrors are taken from the status of the returned object.
+func observeClient[T any](m *Metrics, method string, start time.Time, res T, err error) {
+	var code uint32
+	if obj, ok := any(res).(interface{ GetStatus() api.Status }); ok && err == nil {
+		code = obj.GetStatus().Code
+	}
+	m.observe(method, start, err, code)
+}
+
+// WrapClient returns a Client recording every call, labelled with the name of the Client method, e.g. CreateVirtualIP.
+func (m *Metrics) WrapClient(c client.Client) client.Client {
+	return &instrumentedClient{Client: c, m: m}
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"net/netip"
+	"strings"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/client"
+	"github.com/ironcore-dev/dpservice-go/dpservicetest"
+	"github.com/ironcore-dev/dpservice-go/errors"
+	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+)
+
+var _ = Describe("Metrics", func() {
+	var (
+		server *dpservicetest.Server
+		m      *Metrics
+	)
+
+	BeforeEach(func() {
+		server = dpservicetest.NewServer()
+		server.Start()
+		DeferCleanup(server.Close)
+		m = New()
+	})
+
+	newInterface := func() *api.Interface {
+		ipv4 := netip.MustParseAddr("10.200.1.4")
+		ipv6 := netip.MustParseAddr("2000:200:1::4")
+		return &api.Interface{
+			InterfaceMeta: api.InterfaceMeta{ID: "vm1"},
+			Spec:          api.InterfaceSpec{VNI: 100, Device: "net_tap2", IPv4: &ipv4, IPv6: &ipv6},
+		}
+	}
+
+	It("should record the calls of a wrapped client", func(ctx SpecContext) {
+		conn, err := server.Dial(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(conn.Close)
+		c := m.WrapClient(client.NewClient(dpdkproto.NewDPDKironcoreClient(conn)))
+
+		_, err = c.Initialize(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.CreateInterface(ctx, newInterface())
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.CreateInterface(ctx, newInterface())
+		Expect(errors.IsStatusErrorCode(err, errors.ALREADY_EXISTS)).To(BeTrue())
+		_, err = c.GetVirtualIP(ctx, "vm1", client.IgnoreErrors(errors.SNAT_NO_DATA))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(testutil.ToFloat64(m.requests.WithLabelValues("CreateInterface"))).To(Equal(2.0))
+		Expect(testutil.ToFloat64(m.statusCodes.WithLabelValues("CreateInterface", "ALREADY_EXISTS"))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(m.statusCodes.WithLabelValues("GetVirtualIP", "SNAT_NO_DATA"))).To(Equal(1.0))
+		Expect(testutil.CollectAndCount(m, "dpservice_client_request_duration_seconds")).To(Equal(3))
+	})
+
+	It("should record gRPC calls and transport errors with the interceptor", func(ctx SpecContext) {
+		conn, err := server.Dial(ctx, grpc.WithChainUnaryInterceptor(m.UnaryClientInterceptor()))
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(conn.Close)
+		c := client.NewClient(dpdkproto.NewDPDKironcoreClient(conn))
+
+		_, err = c.CreateInterface(ctx, newInterface())
+		Expect(err).To(HaveOccurred())
+		_, err = c.Initialize(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.DeleteNat(ctx, "vm1")
+		Expect(err).To(HaveOccurred())
+
+		Expect(testutil.CollectAndCompare(m, strings.NewReader(`
+# HELP dpservice_client_requests_total Number of calls to dp-service.
+# TYPE dpservice_client_requests_total counter
+dpservice_client_requests_total{method="CreateInterface"} 1
+dpservice_client_requests_total{method="DeleteNat"} 1
+dpservice_client_requests_total{method="Initialize"} 1
+# HELP dpservice_client_status_codes_total Number of non-zero status codes returned by dp-service.
+# TYPE dpservice_client_status_codes_total counter
+dpservice_client_status_codes_total{method="DeleteNat",status="NO_VM"} 1
+# HELP dpservice_client_transport_errors_total Number of calls to dp-service failing with a gRPC error.
+# TYPE dpservice_client_transport_errors_total counter
+dpservice_client_transport_errors_total{code="Aborted",method="CreateInterface"} 1
+`), "dpservice_client_requests_total", "dpservice_client_status_codes_total", "dpservice_client_transport_errors_total")).To(Succeed())
+	})
+})
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package printer renders api objects and lists as tables, JSON, YAML or names.
+package printer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"sigs.k8s.io/yaml"
+)
+
+// Formats supported by New.
+const (
+	FormatTable = "table"
+	FormatWide  = "wide"
+	FormatJSON  = "json"
+	FormatYAML  = "yaml"
+	FormatName  = "name"
+)
+
+// Printer writes an api.Object or api.List to w.
+type Printer interface {
+	Print(w io.Writer, v any) error
+}
+
+type options struct {
+	sortBy    string
+	wide      bool
+	noHeaders bool
+}
+
+// Option configures the printer returned by New.
+type Option func(*options)
+
+// WithSortBy sorts the rows of a table by the column with the given header, e.g. "VNI".
+func WithSortBy(column string) Option {
+	return func(o *options) {
+		o.sortBy = column
+	}
+}
+
+// WithWide adds the wide columns to a table, the same as the wide format.
+func WithWide() Option {
+	return func(o *options) {
+		o.wide = true
+	}
+}
+
+// WithNoHeaders omits the header row of a table.
+func WithNoHeaders() Option {
+	return func(o *options) {
+		o.noHeaders = true
+	}
+}
+
+// New returns a printer for format, which is one of table, wide, json, yaml or name.
+func New(format string, opts ...Option) (Printer, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	switch strings.ToLower(format) {
+	case FormatTable, "":
+		return &tablePrinter{options: *o}, nil
+	case FormatWide:
+		o.wide = true
+		return &tablePrinter{options: *o}, nil
+	case FormatJSON:
+		return jsonPrinter{}, nil
+	case FormatYAML:
+		return yamlPrinter{}, nil
+	case FormatName:
+		return namePrinter{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported output format %q, one of table|wide|json|yaml|name", format)
+	}
+}
+
+type jsonPrinter struct{}
+
+func (jsonPrinter) Print(w io.Writer, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding json: %w", err)
+	}
+	_, err = fmt.Fprintf(w, "%s\n", data)
+	return err
+}
+
+type yamlPrinter struct{}
+
+func (yamlPrinter) Print(w io.Writer, v any) error {
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("error encoding yaml: %w", err)
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+type namePrinter struct{}
+
+func (namePrinter) Print(w io.Writer, v any) error {
+	objs, err := objects(v)
+	if err != nil {
+		return err
+	}
+	for _, obj := range objs {
+		if _, err := fmt.Fprintf(w, "%s/%s\n", strings.ToLower(kindOf(obj)), obj.GetName()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// objects returns the items of a list or the object itself.
+func objects(v any) ([]api.Object, error) {
+	switch v := v.(type) {
+	case api.List:
+		return v.GetItems(), nil
+	case api.Object:
+		return []api.Object{v}, nil
+	default:
+		return nil, fmt.Errorf("cannot print %T, expected an api.Object or api.List", v)
+	}
+}
+
+// kindOf returns the kind of obj, falling back to its type if the kind is not set.
+func kindOf(obj api.Object) string {
+	if kind := obj.GetKind(); kind != "" {
+		return kind
+	}
+	return strings.TrimPrefix(fmt.Sprintf("%T", obj), "*api.")
+}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package printer
+
+import (
+	"bytes"
+	"net/netip"
+	"strings"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func addr(s string) *netip.Addr {
+	a := netip.MustParseAddr(s)
+	return &a
+}
+
+func printString(format string, v any, opts ...Option) string {
+	p, err := New(format, opts...)
+	Expect(err).NotTo(HaveOccurred())
+	var out bytes.Buffer
+	Expect(p.Print(&out, v)).To(Succeed())
+	return out.String()
+}
+
+var _ = Describe("printer", func() {
+	var list *api.InterfaceList
+
+	BeforeEach(func() {
+		list = &api.InterfaceList{
+			TypeMeta: api.TypeMeta{Kind: api.InterfaceListKind},
+			Items: []api.Interface{
+				{
+					TypeMeta:      api.TypeMeta{Kind: api.InterfaceKind},
+					InterfaceMeta: api.InterfaceMeta{ID: "vm2"},
+					Spec: api.InterfaceSpec{
+						VNI: 200, Device: "net_tap3", IPv4: addr("10.200.1.10"), IPv6: addr("2000:200:1::10"),
+						VirtualFunction: &api.VirtualFunction{Name: "net_tap3"},
+					},
+				},
+				{
+					TypeMeta:      api.TypeMeta{Kind: api.InterfaceKind},
+					InterfaceMeta: api.InterfaceMeta{ID: "vm1"},
+					Spec: api.InterfaceSpec{
+						VNI: 100, Device: "net_tap2", IPv4: addr("10.200.1.9"), IPv6: addr("2000:200:1::9"),
+						UnderlayRoute: addr("fc00:1::1"),
+					},
+				},
+			},
+		}
+	})
+
+	It("should print a table with kind specific columns", func() {
+		lines := strings.Split(strings.TrimSpace(printString(FormatTable, list)), "\n")
+		Expect(lines).To(HaveLen(3))
+		Expect(strings.Fields(lines[0])).To(Equal([]string{"ID", "VNI", "DEVICE", "IPV4", "IPV6", "UNDERLAY", "ROUTE"}))
+		Expect(strings.Fields(lines[1])).To(Equal([]string{"vm2", "200", "net_tap3", "10.200.1.10", "2000:200:1::10"}))
+		Expect(strings.Fields(lines[2])).To(Equal([]string{"vm1", "100", "net_tap2", "10.200.1.9", "2000:200:1::9", "fc00:1::1"}))
+	})
+
+	It("should add the wide columns in wide mode", func() {
+		out := printString(FormatWide, list)
+		Expect(out).To(ContainSubstring("VF"))
+		Expect(out).To(ContainSubstring("STATUS"))
+	})
+
+	It("should sort rows by value", func() {
+		lines := strings.Split(strings.TrimSpace(printString(FormatTable, list, WithSortBy("ipv4"), WithNoHeaders())), "\n")
+		Expect(lines).To(HaveLen(2))
+		Expect(lines[0]).To(HavePrefix("vm1"))
+
+		p, err := New(FormatTable, WithSortBy("unknown"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p.Print(&bytes.Buffer{}, list)).To(MatchError(ContainSubstring("unknown column")))
+	})
+
+	It("should print names, json and yaml", func() {
+		Expect(printString(FormatName, list)).To(Equal("interface/vm2\ninterface/vm1\n"))
+		Expect(printString(FormatJSON, &list.Items[1])).To(ContainSubstring(`"primary_ipv4": "10.200.1.9"`))
+		Expect(printString(FormatYAML, &list.Items[1])).To(ContainSubstring("underlay_route: fc00:1::1"))
+	})
+
+	It("should print load balancer ports by protocol name", func() {
+		lb := &api.LoadBalancer{
+			LoadBalancerMeta: api.LoadBalancerMeta{ID: "lb1"},
+			Spec:             api.LoadBalancerSpec{VNI: 100, LbVipIP: addr("10.20.30.40"), Lbports: []api.LBPort{{Protocol: 6, Port: 443}, {Protocol: 17, Port: 53}}},
+		}
+		Expect(printString(FormatTable, lb)).To(ContainSubstring("tcp/443,udp/53"))
+		Expect(printString(FormatName, lb)).To(Equal("loadbalancer/lb1\n"))
+	})
+
+	It("should reject unknown formats", func() {
+		_, err := New("xml")
+		Expect(err).To(MatchError(ContainSubstring("unsupported output format")))
+	})
+})
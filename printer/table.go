@@ -0,0 +1,394 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package printer
+
+import (
+	"fmt"
+	"io"
+	"net/netip"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
+)
+
+type column struct {
+	header string
+	// wide columns are only shown in wide mode.
+	wide  bool
+	value func(obj api.Object) string
+}
+
+type tablePrinter struct {
+	options
+}
+
+func (p *tablePrinter) Print(w io.Writer, v any) error {
+	objs, err := objects(v)
+	if err != nil {
+		return err
+	}
+
+	var columns []column
+	if list, ok := v.(api.List); ok {
+		columns = listColumns(list)
+	} else {
+		columns = objectColumns(v.(api.Object))
+	}
+	if !p.wide {
+		var narrow []column
+		for _, col := range columns {
+			if !col.wide {
+				narrow = append(narrow, col)
+			}
+		}
+		columns = narrow
+	}
+
+	rows := make([][]string, 0, len(objs))
+	for _, obj := range objs {
+		row := make([]string, 0, len(columns))
+		for _, col := range columns {
+			row = append(row, col.value(obj))
+		}
+		rows = append(rows, row)
+	}
+
+	if p.sortBy != "" {
+		idx := -1
+		for i, col := range columns {
+			if strings.EqualFold(col.header, p.sortBy) {
+				idx = i
+			}
+		}
+		if idx < 0 {
+			return fmt.Errorf("cannot sort by unknown column %q", p.sortBy)
+		}
+		sort.SliceStable(rows, func(i, j int) bool {
+			return less(rows[i][idx], rows[j][idx])
+		})
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	if !p.noHeaders {
+		headers := make([]string, 0, len(columns))
+		for _, col := range columns {
+			headers = append(headers, col.header)
+		}
+		fmt.Fprintln(tw, strings.Join(headers, "\t"))
+	}
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+// less compares numbers, addresses and prefixes by value and everything else as strings.
+func less(a, b string) bool {
+	if x, err := strconv.ParseInt(a, 10, 64); err == nil {
+		if y, err := strconv.ParseInt(b, 10, 64); err == nil {
+			return x < y
+		}
+	}
+	if x, err := netip.ParseAddr(a); err == nil {
+		if y, err := netip.ParseAddr(b); err == nil {
+			return x.Less(y)
+		}
+	}
+	if x, err := netip.ParsePrefix(a); err == nil {
+		if y, err := netip.ParsePrefix(b); err == nil {
+			if x.Addr() != y.Addr() {
+				return x.Addr().Less(y.Addr())
+			}
+			return x.Bits() < y.Bits()
+		}
+	}
+	return a < b
+}
+
+func listColumns(list api.List) []column {
+	switch list.(type) {
+	case *api.InterfaceList:
+		return objectColumns(&api.Interface{})
+	case *api.PrefixList:
+		return objectColumns(&api.Prefix{})
+	case *api.LoadBalancerTargetList:
+		return objectColumns(&api.LoadBalancerTarget{})
+	case *api.RouteList:
+		return objectColumns(&api.Route{})
+	case *api.NatList:
+		return objectColumns(&api.Nat{})
+	case *api.FirewallRuleList:
+		return objectColumns(&api.FirewallRule{})
+	default:
+		return defaultColumns
+	}
+}
+
+var defaultColumns = []column{
+	{header: "KIND", value: kindOf},
+	{header: "NAME", value: func(obj api.Object) string { return obj.GetName() }},
+}
+
+// statusColumn is appended to every table in wide mode.
+var statusColumn = column{header: "STATUS", wide: true, value: func(obj api.Object) string {
+	status := obj.GetStatus()
+	return status.String()
+}}
+
+func objectColumns(obj api.Object) []column {
+	var columns []column
+	switch obj.(type) {
+	case *api.Interface:
+		columns = interfaceColumns
+	case *api.Prefix:
+		columns = prefixColumns
+	case *api.LoadBalancerPrefix:
+		columns = loadBalancerPrefixColumns
+	case *api.VirtualIP:
+		columns = virtualIPColumns
+	case *api.LoadBalancer:
+		columns = loadBalancerColumns
+	case *api.LoadBalancerTarget:
+		columns = loadBalancerTargetColumns
+	case *api.Route:
+		columns = routeColumns
+	case *api.Nat:
+		columns = natColumns
+	case *api.NeighborNat:
+		columns = neighborNatColumns
+	case *api.FirewallRule:
+		columns = firewallRuleColumns
+	case *api.Initialized:
+		columns = initializedColumns
+	case *api.Vni:
+		columns = vniColumns
+	case *api.Version:
+		columns = versionColumns
+	case *api.CaptureStart:
+		columns = captureStartColumns
+	case *api.CaptureStop:
+		columns = captureStopColumns
+	case *api.CaptureStatus:
+		columns = captureStatusColumns
+	default:
+		columns = defaultColumns
+	}
+	return append(columns[:len(columns):len(columns)], statusColumn)
+}
+
+func addrString(addr *netip.Addr) string {
+	if addr == nil || !addr.IsValid() {
+		return ""
+	}
+	return addr.String()
+}
+
+func prefixString(prefix *netip.Prefix) string {
+	if prefix == nil || !prefix.IsValid() {
+		return ""
+	}
+	return prefix.String()
+}
+
+func uintString[T uint8 | uint32 | uint64](v T) string {
+	return strconv.FormatUint(uint64(v), 10)
+}
+
+var interfaceColumns = []column{
+	{header: "ID", value: func(obj api.Object) string { return obj.(*api.Interface).ID }},
+	{header: "VNI", value: func(obj api.Object) string { return uintString(obj.(*api.Interface).Spec.VNI) }},
+	{header: "DEVICE", value: func(obj api.Object) string { return obj.(*api.Interface).Spec.Device }},
+	{header: "IPV4", value: func(obj api.Object) string { return addrString(obj.(*api.Interface).Spec.IPv4) }},
+	{header: "IPV6", value: func(obj api.Object) string { return addrString(obj.(*api.Interface).Spec.IPv6) }},
+	{header: "UNDERLAY ROUTE", value: func(obj api.Object) string { return addrString(obj.(*api.Interface).Spec.UnderlayRoute) }},
+	{header: "VF", wide: true, value: func(obj api.Object) string {
+		if vf := obj.(*api.Interface).Spec.VirtualFunction; vf != nil {
+			return vf.Name
+		}
+		return ""
+	}},
+	{header: "TOTAL RATE", wide: true, value: func(obj api.Object) string {
+		if metering := obj.(*api.Interface).Spec.Metering; metering != nil {
+			return uintString(metering.TotalRate)
+		}
+		return ""
+	}},
+	{header: "PUBLIC RATE", wide: true, value: func(obj api.Object) string {
+		if metering := obj.(*api.Interface).Spec.Metering; metering != nil {
+			return uintString(metering.PublicRate)
+		}
+		return ""
+	}},
+}
+
+var prefixColumns = []column{
+	{header: "INTERFACE ID", value: func(obj api.Object) string { return obj.(*api.Prefix).InterfaceID }},
+	{header: "PREFIX", value: func(obj api.Object) string { return prefixString(&obj.(*api.Prefix).Spec.Prefix) }},
+	{header: "UNDERLAY ROUTE", value: func(obj api.Object) string { return addrString(obj.(*api.Prefix).Spec.UnderlayRoute) }},
+}
+
+var loadBalancerPrefixColumns = []column{
+	{header: "INTERFACE ID", value: func(obj api.Object) string { return obj.(*api.LoadBalancerPrefix).InterfaceID }},
+	{header: "PREFIX", value: func(obj api.Object) string { return prefixString(&obj.(*api.LoadBalancerPrefix).Spec.Prefix) }},
+	{header: "UNDERLAY ROUTE", value: func(obj api.Object) string {
+		return addrString(obj.(*api.LoadBalancerPrefix).Spec.UnderlayRoute)
+	}},
+}
+
+var virtualIPColumns = []column{
+	{header: "INTERFACE ID", value: func(obj api.Object) string { return obj.(*api.VirtualIP).InterfaceID }},
+	{header: "IP", value: func(obj api.Object) string { return addrString(obj.(*api.VirtualIP).Spec.IP) }},
+	{header: "UNDERLAY ROUTE", value: func(obj api.Object) string { return addrString(obj.(*api.VirtualIP).Spec.UnderlayRoute) }},
+}
+
+var loadBalancerColumns = []column{
+	{header: "ID", value: func(obj api.Object) string { return obj.(*api.LoadBalancer).ID }},
+	{header: "VNI", value: func(obj api.Object) string { return uintString(obj.(*api.LoadBalancer).Spec.VNI) }},
+	{header: "IP", value: func(obj api.Object) string { return addrString(obj.(*api.LoadBalancer).Spec.LbVipIP) }},
+	{header: "PORTS", value: func(obj api.Object) string { return portsString(obj.(*api.LoadBalancer).Spec.Lbports) }},
+	{header: "UNDERLAY ROUTE", value: func(obj api.Object) string { return addrString(obj.(*api.LoadBalancer).Spec.UnderlayRoute) }},
+}
+
+func portsString(ports []api.LBPort) string {
+	res := make([]string, 0, len(ports))
+	for _, port := range ports {
+		protocol := strings.ToLower(dpdkproto.Protocol(port.Protocol).String())
+		res = append(res, fmt.Sprintf("%s/%d", protocol, port.Port))
+	}
+	return strings.Join(res, ",")
+}
+
+var loadBalancerTargetColumns = []column{
+	{header: "LOADBALANCER ID", value: func(obj api.Object) string { return obj.(*api.LoadBalancerTarget).LoadbalancerID }},
+	{header: "TARGET IP", value: func(obj api.Object) string { return addrString(obj.(*api.LoadBalancerTarget).Spec.TargetIP) }},
+}
+
+var routeColumns = []column{
+	{header: "VNI", value: func(obj api.Object) string { return uintString(obj.(*api.Route).VNI) }},
+	{header: "PREFIX", value: func(obj api.Object) string { return prefixString(obj.(*api.Route).Spec.Prefix) }},
+	{header: "NEXT HOP VNI", value: func(obj api.Object) string {
+		if nextHop := obj.(*api.Route).Spec.NextHop; nextHop != nil {
+			return uintString(nextHop.VNI)
+		}
+		return ""
+	}},
+	{header: "NEXT HOP IP", value: func(obj api.Object) string {
+		if nextHop := obj.(*api.Route).Spec.NextHop; nextHop != nil {
+			return addrString(nextHop.IP)
+		}
+		return ""
+	}},
+}
+
+var natColumns = []column{
+	{header: "INTERFACE ID", value: func(obj api.Object) string { return obj.(*api.Nat).InterfaceID }},
+	{header: "NAT IP", value: func(obj api.Object) string { return addrString(obj.(*api.Nat).Spec.NatIP) }},
+	{header: "MIN PORT", value: func(obj api.Object) string { return uintString(obj.(*api.Nat).Spec.MinPort) }},
+	{header: "MAX PORT", value: func(obj api.Object) string { return uintString(obj.(*api.Nat).Spec.MaxPort) }},
+	{header: "UNDERLAY ROUTE", value: func(obj api.Object) string { return addrString(obj.(*api.Nat).Spec.UnderlayRoute) }},
+	{header: "VNI", wide: true, value: func(obj api.Object) string { return uintString(obj.(*api.Nat).Spec.Vni) }},
+}
+
+var neighborNatColumns = []column{
+	{header: "NAT IP", value: func(obj api.Object) string { return addrString(obj.(*api.NeighborNat).NatIP) }},
+	{header: "VNI", value: func(obj api.Object) string { return uintString(obj.(*api.NeighborNat).Spec.Vni) }},
+	{header: "MIN PORT", value: func(obj api.Object) string { return uintString(obj.(*api.NeighborNat).Spec.MinPort) }},
+	{header: "MAX PORT", value: func(obj api.Object) string { return uintString(obj.(*api.NeighborNat).Spec.MaxPort) }},
+	{header: "UNDERLAY ROUTE", value: func(obj api.Object) string { return addrString(obj.(*api.NeighborNat).Spec.UnderlayRoute) }},
+}
+
+var firewallRuleColumns = []column{
+	{header: "INTERFACE ID", value: func(obj api.Object) string { return obj.(*api.FirewallRule).InterfaceID }},
+	{header: "ID", value: func(obj api.Object) string { return obj.(*api.FirewallRule).Spec.RuleID }},
+	{header: "DIRECTION", value: func(obj api.Object) string { return obj.(*api.FirewallRule).Spec.TrafficDirection }},
+	{header: "ACTION", value: func(obj api.Object) string { return obj.(*api.FirewallRule).Spec.FirewallAction }},
+	{header: "PRIORITY", value: func(obj api.Object) string { return uintString(obj.(*api.FirewallRule).Spec.Priority) }},
+	{header: "SOURCE", value: func(obj api.Object) string { return prefixString(obj.(*api.FirewallRule).Spec.SourcePrefix) }},
+	{header: "DESTINATION", value: func(obj api.Object) string {
+		return prefixString(obj.(*api.FirewallRule).Spec.DestinationPrefix)
+	}},
+	{header: "PROTOCOL FILTER", wide: true, value: func(obj api.Object) string {
+		return protocolFilterString(obj.(*api.FirewallRule).Spec.ProtocolFilter)
+	}},
+}
+
+func protocolFilterString(filter *dpdkproto.ProtocolFilter) string {
+	switch {
+	case filter.GetTcp() != nil:
+		tcp := filter.GetTcp()
+		return fmt.Sprintf("tcp src:%d-%d dst:%d-%d", tcp.SrcPortLower, tcp.SrcPortUpper, tcp.DstPortLower, tcp.DstPortUpper)
+	case filter.GetUdp() != nil:
+		udp := filter.GetUdp()
+		return fmt.Sprintf("udp src:%d-%d dst:%d-%d", udp.SrcPortLower, udp.SrcPortUpper, udp.DstPortLower, udp.DstPortUpper)
+	case filter.GetIcmp() != nil:
+		icmp := filter.GetIcmp()
+		return fmt.Sprintf("icmp type:%d code:%d", icmp.IcmpType, icmp.IcmpCode)
+	default:
+		return "any"
+	}
+}
+
+var initializedColumns = []column{
+	{header: "UUID", value: func(obj api.Object) string { return obj.(*api.Initialized).Spec.UUID }},
+}
+
+var vniColumns = []column{
+	{header: "VNI", value: func(obj api.Object) string { return uintString(obj.(*api.Vni).VNI) }},
+	{header: "TYPE", value: func(obj api.Object) string { return dpdkproto.VniType(obj.(*api.Vni).VniType).String() }},
+	{header: "IN USE", value: func(obj api.Object) string { return strconv.FormatBool(obj.(*api.Vni).Spec.InUse) }},
+}
+
+var versionColumns = []column{
+	{header: "SERVICE PROTOCOL", value: func(obj api.Object) string { return obj.(*api.Version).Spec.ServiceProtocol }},
+	{header: "SERVICE VERSION", value: func(obj api.Object) string { return obj.(*api.Version).Spec.ServiceVersion }},
+	{header: "CLIENT PROTOCOL", wide: true, value: func(obj api.Object) string { return obj.(*api.Version).ClientProtocol }},
+	{header: "CLIENT NAME", wide: true, value: func(obj api.Object) string { return obj.(*api.Version).ClientName }},
+	{header: "CLIENT VERSION", wide: true, value: func(obj api.Object) string { return obj.(*api.Version).ClientVersion }},
+}
+
+func captureInterfacesString(ifaces []api.CaptureInterface) string {
+	res := make([]string, 0, len(ifaces))
+	for _, iface := range ifaces {
+		res = append(res, iface.InterfaceType+":"+iface.InterfaceInfo)
+	}
+	return strings.Join(res, ",")
+}
+
+var captureStartColumns = []column{
+	{header: "SINK NODE IP", value: func(obj api.Object) string {
+		if config := obj.(*api.CaptureStart).Config; config != nil {
+			return addrString(config.SinkNodeIP)
+		}
+		return ""
+	}},
+	{header: "INTERFACES", value: func(obj api.Object) string {
+		return captureInterfacesString(obj.(*api.CaptureStart).Spec.Interfaces)
+	}},
+}
+
+var captureStopColumns = []column{
+	{header: "INTERFACE COUNT", value: func(obj api.Object) string { return uintString(obj.(*api.CaptureStop).Spec.InterfaceCount) }},
+}
+
+var captureStatusColumns = []column{
+	{header: "ACTIVE", value: func(obj api.Object) string {
+		return strconv.FormatBool(obj.(*api.CaptureStatus).Spec.OperationStatus)
+	}},
+	{header: "SINK NODE IP", value: func(obj api.Object) string {
+		return addrString(obj.(*api.CaptureStatus).Spec.Config.SinkNodeIP)
+	}},
+	{header: "UDP SRC PORT", wide: true, value: func(obj api.Object) string {
+		return uintString(obj.(*api.CaptureStatus).Spec.Config.UdpSrcPort)
+	}},
+	{header: "UDP DST PORT", wide: true, value: func(obj api.Object) string {
+		return uintString(obj.(*api.CaptureStatus).Spec.Config.UdpDstPort)
+	}},
+	{header: "INTERFACES", value: func(obj api.Object) string {
+		return captureInterfacesString(obj.(*api.CaptureStatus).Spec.Interfaces)
+	}},
+}
@@ -0,0 +1,797 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/client"
+	"github.com/ironcore-dev/dpservice-go/errors"
+	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// Phases in creation order, deletions run in reverse.
+const (
+	phaseInterface = iota
+	phasePrefix
+	phaseLoadBalancerPrefix
+	phaseVirtualIP
+	phaseNat
+	phaseFirewallRule
+	phaseLoadBalancer
+	phaseRoute
+	phaseLoadBalancerTarget
+	phaseNeighborNat
+	phaseCount
+)
+
+type planner struct {
+	c       client.Client
+	creates [phaseCount][]Action
+	deletes [phaseCount][]Action
+}
+
+func (p *planner) create(phase int, obj api.Object, apply func(ctx context.Context) error) {
+	p.creates[phase] = append(p.creates[phase], Action{Operation: Create, Object: obj, apply: apply})
+}
+
+func (p *planner) delete(phase int, obj api.Object, apply func(ctx context.Context) error) {
+	p.deletes[phase] = append(p.deletes[phase], Action{Operation: Delete, Object: obj, apply: apply})
+}
+
+func (p *planner) actions() []Action {
+	var actions []Action
+	for phase := phaseCount - 1; phase >= 0; phase-- {
+		actions = append(actions, p.deletes[phase]...)
+	}
+	for phase := 0; phase < phaseCount; phase++ {
+		actions = append(actions, p.creates[phase]...)
+	}
+	return actions
+}
+
+// Plan computes the actions Reconcile would apply to converge dp-service to desired.
+func Plan(ctx context.Context, c client.Client, desired *NodeState) ([]Action, error) {
+	p := &planner{c: c}
+
+	// VNIs that keep at least one user during reconciliation keep their routes.
+	survivingVNIs := map[uint32]bool{}
+	// VNIs that lose all of their interfaces and load balancers and with it their routing table.
+	resetVNIs := map[uint32]bool{}
+
+	if err := p.planInterfaces(ctx, desired, survivingVNIs, resetVNIs); err != nil {
+		return nil, err
+	}
+	if err := p.planLoadBalancers(ctx, desired, survivingVNIs, resetVNIs); err != nil {
+		return nil, err
+	}
+	for vni := range survivingVNIs {
+		delete(resetVNIs, vni)
+	}
+	if err := p.planRoutes(ctx, desired, resetVNIs); err != nil {
+		return nil, err
+	}
+	if err := p.planNeighborNats(ctx, desired); err != nil {
+		return nil, err
+	}
+
+	return p.actions(), nil
+}
+
+func (p *planner) planInterfaces(ctx context.Context, desired *NodeState, survivingVNIs, resetVNIs map[uint32]bool) error {
+	current, err := checkList(p.c.ListInterfaces(ctx))
+	if err != nil {
+		return fmt.Errorf("error listing interfaces: %w", err)
+	}
+
+	desiredByID := make(map[string]*InterfaceState, len(desired.Interfaces))
+	for i := range desired.Interfaces {
+		desiredByID[desired.Interfaces[i].Interface.ID] = &desired.Interfaces[i]
+	}
+
+	currentByID := make(map[string]*api.Interface, len(current.Items))
+	for i := range current.Items {
+		iface := &current.Items[i]
+		currentByID[iface.ID] = iface
+
+		want, ok := desiredByID[iface.ID]
+		if ok && interfaceEqual(iface, &want.Interface) {
+			survivingVNIs[iface.Spec.VNI] = true
+			continue
+		}
+		resetVNIs[iface.Spec.VNI] = true
+		p.deleteInterface(iface.ID)
+	}
+
+	for i := range desired.Interfaces {
+		want := &desired.Interfaces[i]
+		cur, ok := currentByID[want.Interface.ID]
+		if ok && interfaceEqual(cur, &want.Interface) {
+			if err := p.planInterfaceChildren(ctx, want); err != nil {
+				return err
+			}
+			continue
+		}
+
+		p.createInterface(want)
+		p.createInterfaceChildren(want)
+	}
+	return nil
+}
+
+func (p *planner) deleteInterface(id string) {
+	obj := &api.Interface{
+		TypeMeta:      api.TypeMeta{Kind: api.InterfaceKind},
+		InterfaceMeta: api.InterfaceMeta{ID: id},
+	}
+	p.delete(phaseInterface, obj, func(ctx context.Context) error {
+		_, err := p.c.DeleteInterface(ctx, id)
+		return err
+	})
+}
+
+func (p *planner) createInterface(want *InterfaceState) {
+	obj := want.Interface
+	obj.Kind = api.InterfaceKind
+	obj.Spec.Nat = nil
+	obj.Spec.VIP = nil
+	p.create(phaseInterface, &obj, func(ctx context.Context) error {
+		_, err := p.c.CreateInterface(ctx, &obj)
+		return err
+	})
+}
+
+// createInterfaceChildren creates everything attached to a new or recreated interface.
+func (p *planner) createInterfaceChildren(want *InterfaceState) {
+	id := want.Interface.ID
+	for _, prefix := range want.Prefixes {
+		p.createPrefix(id, prefix)
+	}
+	for _, prefix := range want.LoadBalancerPrefixes {
+		p.createLoadBalancerPrefix(id, prefix)
+	}
+	if want.VirtualIP != nil {
+		p.createVirtualIP(id, *want.VirtualIP)
+	}
+	if want.Nat != nil {
+		p.createNat(id, *want.Nat)
+	}
+	for _, rule := range want.FirewallRules {
+		p.createFirewallRule(id, rule)
+	}
+}
+
+// planInterfaceChildren diffs everything attached to an interface that is kept as is.
+func (p *planner) planInterfaceChildren(ctx context.Context, want *InterfaceState) error {
+	id := want.Interface.ID
+
+	prefixes, err := checkList(p.c.ListPrefixes(ctx, id))
+	if err != nil {
+		return fmt.Errorf("error listing prefixes of interface %s: %w", id, err)
+	}
+	currentPrefixes := make([]netip.Prefix, 0, len(prefixes.Items))
+	for _, prefix := range prefixes.Items {
+		currentPrefixes = append(currentPrefixes, prefix.Spec.Prefix)
+	}
+	toCreate, toDelete := diffPrefixes(want.Prefixes, currentPrefixes)
+	for _, prefix := range toDelete {
+		p.deletePrefix(id, prefix)
+	}
+	for _, prefix := range toCreate {
+		p.createPrefix(id, prefix)
+	}
+
+	lbPrefixes, err := checkList(p.c.ListLoadBalancerPrefixes(ctx, id))
+	if err != nil {
+		return fmt.Errorf("error listing loadbalancer prefixes of interface %s: %w", id, err)
+	}
+	currentLBPrefixes := make([]netip.Prefix, 0, len(lbPrefixes.Items))
+	for _, prefix := range lbPrefixes.Items {
+		currentLBPrefixes = append(currentLBPrefixes, prefix.Spec.Prefix)
+	}
+	toCreate, toDelete = diffPrefixes(want.LoadBalancerPrefixes, currentLBPrefixes)
+	for _, prefix := range toDelete {
+		p.deleteLoadBalancerPrefix(id, prefix)
+	}
+	for _, prefix := range toCreate {
+		p.createLoadBalancerPrefix(id, prefix)
+	}
+
+	vip, err := p.c.GetVirtualIP(ctx, id, client.IgnoreErrors(errors.SNAT_NO_DATA))
+	if err != nil {
+		return fmt.Errorf("error getting virtual ip of interface %s: %w", id, err)
+	}
+	hasVIP := vip.Status.Code == 0
+	switch {
+	case hasVIP && want.VirtualIP == nil:
+		p.deleteVirtualIP(id)
+	case !hasVIP && want.VirtualIP != nil:
+		p.createVirtualIP(id, *want.VirtualIP)
+	case hasVIP && !addrEqual(vip.Spec.IP, want.VirtualIP):
+		p.deleteVirtualIP(id)
+		p.createVirtualIP(id, *want.VirtualIP)
+	}
+
+	nat, err := p.c.GetNat(ctx, id, client.IgnoreErrors(errors.SNAT_NO_DATA))
+	if err != nil {
+		return fmt.Errorf("error getting nat of interface %s: %w", id, err)
+	}
+	hasNat := nat.Status.Code == 0
+	switch {
+	case hasNat && want.Nat == nil:
+		p.deleteNat(id)
+	case !hasNat && want.Nat != nil:
+		p.createNat(id, *want.Nat)
+	case hasNat && !natEqual(&nat.Spec, want.Nat):
+		p.deleteNat(id)
+		p.createNat(id, *want.Nat)
+	}
+
+	rules, err := checkList(p.c.ListFirewallRules(ctx, id))
+	if err != nil {
+		return fmt.Errorf("error listing firewall rules of interface %s: %w", id, err)
+	}
+	currentRules := make(map[string]*api.FirewallRuleSpec, len(rules.Items))
+	for i := range rules.Items {
+		currentRules[rules.Items[i].Spec.RuleID] = &rules.Items[i].Spec
+	}
+	desiredRules := make(map[string]bool, len(want.FirewallRules))
+	for _, rule := range want.FirewallRules {
+		desiredRules[rule.RuleID] = true
+		cur, ok := currentRules[rule.RuleID]
+		if ok && firewallRuleEqual(cur, &rule) {
+			continue
+		}
+		if ok {
+			p.deleteFirewallRule(id, rule.RuleID)
+		}
+		p.createFirewallRule(id, rule)
+	}
+	for _, rule := range rules.Items {
+		if !desiredRules[rule.Spec.RuleID] {
+			p.deleteFirewallRule(id, rule.Spec.RuleID)
+		}
+	}
+	return nil
+}
+
+func (p *planner) createPrefix(id string, prefix netip.Prefix) {
+	obj := &api.Prefix{
+		TypeMeta:   api.TypeMeta{Kind: api.PrefixKind},
+		PrefixMeta: api.PrefixMeta{InterfaceID: id},
+		Spec:       api.PrefixSpec{Prefix: prefix},
+	}
+	p.create(phasePrefix, obj, func(ctx context.Context) error {
+		_, err := p.c.CreatePrefix(ctx, obj)
+		return err
+	})
+}
+
+func (p *planner) deletePrefix(id string, prefix netip.Prefix) {
+	obj := &api.Prefix{
+		TypeMeta:   api.TypeMeta{Kind: api.PrefixKind},
+		PrefixMeta: api.PrefixMeta{InterfaceID: id},
+		Spec:       api.PrefixSpec{Prefix: prefix},
+	}
+	p.delete(phasePrefix, obj, func(ctx context.Context) error {
+		_, err := p.c.DeletePrefix(ctx, id, &prefix)
+		return err
+	})
+}
+
+func (p *planner) createLoadBalancerPrefix(id string, prefix netip.Prefix) {
+	obj := &api.LoadBalancerPrefix{
+		TypeMeta:               api.TypeMeta{Kind: api.LoadBalancerPrefixKind},
+		LoadBalancerPrefixMeta: api.LoadBalancerPrefixMeta{InterfaceID: id},
+		Spec:                   api.LoadBalancerPrefixSpec{Prefix: prefix},
+	}
+	p.create(phaseLoadBalancerPrefix, obj, func(ctx context.Context) error {
+		_, err := p.c.CreateLoadBalancerPrefix(ctx, obj)
+		return err
+	})
+}
+
+func (p *planner) deleteLoadBalancerPrefix(id string, prefix netip.Prefix) {
+	obj := &api.LoadBalancerPrefix{
+		TypeMeta:               api.TypeMeta{Kind: api.LoadBalancerPrefixKind},
+		LoadBalancerPrefixMeta: api.LoadBalancerPrefixMeta{InterfaceID: id},
+		Spec:                   api.LoadBalancerPrefixSpec{Prefix: prefix},
+	}
+	p.delete(phaseLoadBalancerPrefix, obj, func(ctx context.Context) error {
+		_, err := p.c.DeleteLoadBalancerPrefix(ctx, id, &prefix)
+		return err
+	})
+}
+
+func (p *planner) createVirtualIP(id string, ip netip.Addr) {
+	obj := &api.VirtualIP{
+		TypeMeta:      api.TypeMeta{Kind: api.VirtualIPKind},
+		VirtualIPMeta: api.VirtualIPMeta{InterfaceID: id},
+		Spec:          api.VirtualIPSpec{IP: &ip},
+	}
+	p.create(phaseVirtualIP, obj, func(ctx context.Context) error {
+		_, err := p.c.CreateVirtualIP(ctx, obj)
+		return err
+	})
+}
+
+func (p *planner) deleteVirtualIP(id string) {
+	obj := &api.VirtualIP{
+		TypeMeta:      api.TypeMeta{Kind: api.VirtualIPKind},
+		VirtualIPMeta: api.VirtualIPMeta{InterfaceID: id},
+	}
+	p.delete(phaseVirtualIP, obj, func(ctx context.Context) error {
+		_, err := p.c.DeleteVirtualIP(ctx, id)
+		return err
+	})
+}
+
+func (p *planner) createNat(id string, spec api.NatSpec) {
+	obj := &api.Nat{
+		TypeMeta: api.TypeMeta{Kind: api.NatKind},
+		NatMeta:  api.NatMeta{InterfaceID: id},
+		Spec:     spec,
+	}
+	p.create(phaseNat, obj, func(ctx context.Context) error {
+		_, err := p.c.CreateNat(ctx, obj)
+		return err
+	})
+}
+
+func (p *planner) deleteNat(id string) {
+	obj := &api.Nat{
+		TypeMeta: api.TypeMeta{Kind: api.NatKind},
+		NatMeta:  api.NatMeta{InterfaceID: id},
+	}
+	p.delete(phaseNat, obj, func(ctx context.Context) error {
+		_, err := p.c.DeleteNat(ctx, id)
+		return err
+	})
+}
+
+func (p *planner) createFirewallRule(id string, spec api.FirewallRuleSpec) {
+	obj := &api.FirewallRule{
+		TypeMeta:         api.TypeMeta{Kind: api.FirewallRuleKind},
+		FirewallRuleMeta: api.FirewallRuleMeta{InterfaceID: id},
+		Spec:             spec,
+	}
+	p.create(phaseFirewallRule, obj, func(ctx context.Context) error {
+		_, err := p.c.CreateFirewallRule(ctx, obj)
+		return err
+	})
+}
+
+func (p *planner) deleteFirewallRule(id, ruleID string) {
+	obj := &api.FirewallRule{
+		TypeMeta:         api.TypeMeta{Kind: api.FirewallRuleKind},
+		FirewallRuleMeta: api.FirewallRuleMeta{InterfaceID: id},
+		Spec:             api.FirewallRuleSpec{RuleID: ruleID},
+	}
+	p.delete(phaseFirewallRule, obj, func(ctx context.Context) error {
+		_, err := p.c.DeleteFirewallRule(ctx, id, ruleID)
+		return err
+	})
+}
+
+func (p *planner) planLoadBalancers(ctx context.Context, desired *NodeState, survivingVNIs, resetVNIs map[uint32]bool) error {
+	for i := range desired.LoadBalancers {
+		want := &desired.LoadBalancers[i]
+		id := want.LoadBalancer.ID
+
+		cur, err := p.c.GetLoadBalancer(ctx, id, client.IgnoreErrors(errors.NOT_FOUND))
+		if err != nil {
+			return fmt.Errorf("error getting loadbalancer %s: %w", id, err)
+		}
+		exists := cur.Status.Code == 0
+
+		if exists && loadBalancerEqual(&cur.Spec, &want.LoadBalancer.Spec) {
+			survivingVNIs[cur.Spec.VNI] = true
+
+			targets, err := checkList(p.c.ListLoadBalancerTargets(ctx, id))
+			if err != nil {
+				return fmt.Errorf("error listing targets of loadbalancer %s: %w", id, err)
+			}
+			currentTargets := make([]netip.Addr, 0, len(targets.Items))
+			for _, target := range targets.Items {
+				if target.Spec.TargetIP != nil {
+					currentTargets = append(currentTargets, *target.Spec.TargetIP)
+				}
+			}
+			toCreate, toDelete := diffAddrs(want.Targets, currentTargets)
+			for _, target := range toDelete {
+				p.deleteLoadBalancerTarget(id, target)
+			}
+			for _, target := range toCreate {
+				p.createLoadBalancerTarget(id, target)
+			}
+			continue
+		}
+
+		if exists {
+			resetVNIs[cur.Spec.VNI] = true
+			p.deleteLoadBalancer(id)
+		}
+		p.createLoadBalancer(want)
+		for _, target := range want.Targets {
+			p.createLoadBalancerTarget(id, target)
+		}
+	}
+	return nil
+}
+
+func (p *planner) createLoadBalancer(want *LoadBalancerState) {
+	obj := want.LoadBalancer
+	obj.Kind = api.LoadBalancerKind
+	p.create(phaseLoadBalancer, &obj, func(ctx context.Context) error {
+		_, err := p.c.CreateLoadBalancer(ctx, &obj)
+		return err
+	})
+}
+
+func (p *planner) deleteLoadBalancer(id string) {
+	obj := &api.LoadBalancer{
+		TypeMeta:         api.TypeMeta{Kind: api.LoadBalancerKind},
+		LoadBalancerMeta: api.LoadBalancerMeta{ID: id},
+	}
+	p.delete(phaseLoadBalancer, obj, func(ctx context.Context) error {
+		_, err := p.c.DeleteLoadBalancer(ctx, id)
+		return err
+	})
+}
+
+func (p *planner) createLoadBalancerTarget(id string, target netip.Addr) {
+	obj := &api.LoadBalancerTarget{
+		TypeMeta:               api.TypeMeta{Kind: api.LoadBalancerTargetKind},
+		LoadBalancerTargetMeta: api.LoadBalancerTargetMeta{LoadbalancerID: id},
+		Spec:                   api.LoadBalancerTargetSpec{TargetIP: &target},
+	}
+	p.create(phaseLoadBalancerTarget, obj, func(ctx context.Context) error {
+		_, err := p.c.CreateLoadBalancerTarget(ctx, obj)
+		return err
+	})
+}
+
+func (p *planner) deleteLoadBalancerTarget(id string, target netip.Addr) {
+	obj := &api.LoadBalancerTarget{
+		TypeMeta:               api.TypeMeta{Kind: api.LoadBalancerTargetKind},
+		LoadBalancerTargetMeta: api.LoadBalancerTargetMeta{LoadbalancerID: id},
+		Spec:                   api.LoadBalancerTargetSpec{TargetIP: &target},
+	}
+	p.delete(phaseLoadBalancerTarget, obj, func(ctx context.Context) error {
+		_, err := p.c.DeleteLoadBalancerTarget(ctx, id, &target)
+		return err
+	})
+}
+
+func (p *planner) planRoutes(ctx context.Context, desired *NodeState, resetVNIs map[uint32]bool) error {
+	vnis := map[uint32]bool{}
+	for vni := range desired.Routes {
+		vnis[vni] = true
+	}
+	for _, iface := range desired.Interfaces {
+		vnis[iface.Interface.Spec.VNI] = true
+	}
+	for _, lb := range desired.LoadBalancers {
+		vnis[lb.LoadBalancer.Spec.VNI] = true
+	}
+
+	for _, vni := range sortedVNIs(vnis) {
+		current := map[netip.Prefix]*api.Route{}
+		if !resetVNIs[vni] {
+			routes, err := p.c.ListRoutes(ctx, vni)
+			// dp-service reports NO_VNI for a VNI it holds nothing for, which has no routes.
+			if err == nil && routes.Status.Code == errors.NO_VNI {
+				routes = &api.RouteList{}
+			}
+			routes, err = checkList(routes, err)
+			if err != nil {
+				return fmt.Errorf("error listing routes of vni %d: %w", vni, err)
+			}
+			for i := range routes.Items {
+				route := &routes.Items[i]
+				if route.Spec.Prefix != nil {
+					current[route.Spec.Prefix.Masked()] = route
+				}
+			}
+		}
+
+		wanted := map[netip.Prefix]bool{}
+		for _, spec := range desired.Routes[vni] {
+			if spec.Prefix == nil {
+				return fmt.Errorf("route in vni %d has no prefix", vni)
+			}
+			prefix := spec.Prefix.Masked()
+			wanted[prefix] = true
+
+			cur, ok := current[prefix]
+			if ok && routeEqual(&cur.Spec, &spec) {
+				continue
+			}
+			if ok {
+				p.deleteRoute(cur)
+			}
+			p.createRoute(vni, spec)
+		}
+		for prefix, cur := range current {
+			if !wanted[prefix] {
+				p.deleteRoute(cur)
+			}
+		}
+	}
+	return nil
+}
+
+func (p *planner) createRoute(vni uint32, spec api.RouteSpec) {
+	obj := &api.Route{
+		TypeMeta:  api.TypeMeta{Kind: api.RouteKind},
+		RouteMeta: api.RouteMeta{VNI: vni},
+		Spec:      spec,
+	}
+	if obj.Spec.NextHop == nil {
+		obj.Spec.NextHop = &api.RouteNextHop{}
+	}
+	p.create(phaseRoute, obj, func(ctx context.Context) error {
+		_, err := p.c.CreateRoute(ctx, &api.Route{RouteMeta: obj.RouteMeta, Spec: spec})
+		return err
+	})
+}
+
+func (p *planner) deleteRoute(route *api.Route) {
+	obj := &api.Route{
+		TypeMeta:  api.TypeMeta{Kind: api.RouteKind},
+		RouteMeta: route.RouteMeta,
+		Spec:      route.Spec,
+	}
+	if obj.Spec.NextHop == nil {
+		obj.Spec.NextHop = &api.RouteNextHop{}
+	}
+	p.delete(phaseRoute, obj, func(ctx context.Context) error {
+		_, err := p.c.DeleteRoute(ctx, obj.VNI, obj.Spec.Prefix)
+		return err
+	})
+}
+
+type neighborNatKey struct {
+	vni     uint32
+	minPort uint32
+	maxPort uint32
+}
+
+func (p *planner) planNeighborNats(ctx context.Context, desired *NodeState) error {
+	natIPs := map[netip.Addr]bool{}
+	wanted := map[netip.Addr]map[neighborNatKey]*api.NeighborNat{}
+	for i := range desired.NeighborNats {
+		nnat := &desired.NeighborNats[i]
+		if nnat.NatIP == nil {
+			return fmt.Errorf("neighbor nat has no nat ip")
+		}
+		natIPs[*nnat.NatIP] = true
+		if wanted[*nnat.NatIP] == nil {
+			wanted[*nnat.NatIP] = map[neighborNatKey]*api.NeighborNat{}
+		}
+		wanted[*nnat.NatIP][neighborNatKey{vni: nnat.Spec.Vni, minPort: nnat.Spec.MinPort, maxPort: nnat.Spec.MaxPort}] = nnat
+	}
+	for _, iface := range desired.Interfaces {
+		if iface.Nat != nil && iface.Nat.NatIP != nil {
+			natIPs[*iface.Nat.NatIP] = true
+		}
+	}
+
+	ips := make([]netip.Addr, 0, len(natIPs))
+	for ip := range natIPs {
+		ips = append(ips, ip)
+	}
+	sort.Slice(ips, func(a, b int) bool {
+		return ips[a].Less(ips[b])
+	})
+
+	for _, ip := range ips {
+		ip := ip
+		current, err := checkList(p.c.ListNeighborNats(ctx, &ip))
+		if err != nil {
+			return fmt.Errorf("error listing neighbor nats of %s: %w", ip, err)
+		}
+		seen := map[neighborNatKey]bool{}
+		for _, nat := range current.Items {
+			key := neighborNatKey{vni: nat.Spec.Vni, minPort: nat.Spec.MinPort, maxPort: nat.Spec.MaxPort}
+			seen[key] = true
+
+			cur := &api.NeighborNat{
+				TypeMeta:        api.TypeMeta{Kind: api.NeighborNatKind},
+				NeighborNatMeta: api.NeighborNatMeta{NatIP: &ip},
+				Spec: api.NeighborNatSpec{
+					Vni:           nat.Spec.Vni,
+					MinPort:       nat.Spec.MinPort,
+					MaxPort:       nat.Spec.MaxPort,
+					UnderlayRoute: nat.Spec.UnderlayRoute,
+				},
+			}
+			want, ok := wanted[ip][key]
+			if ok && addrEqual(cur.Spec.UnderlayRoute, want.Spec.UnderlayRoute) {
+				continue
+			}
+			p.deleteNeighborNat(cur)
+			if ok {
+				p.createNeighborNat(want)
+			}
+		}
+		for key, want := range wanted[ip] {
+			if !seen[key] {
+				p.createNeighborNat(want)
+			}
+		}
+	}
+	return nil
+}
+
+func (p *planner) createNeighborNat(want *api.NeighborNat) {
+	obj := *want
+	obj.Kind = api.NeighborNatKind
+	p.create(phaseNeighborNat, &obj, func(ctx context.Context) error {
+		_, err := p.c.CreateNeighborNat(ctx, &obj)
+		return err
+	})
+}
+
+func (p *planner) deleteNeighborNat(obj *api.NeighborNat) {
+	p.delete(phaseNeighborNat, obj, func(ctx context.Context) error {
+		_, err := p.c.DeleteNeighborNat(ctx, obj)
+		return err
+	})
+}
+
+func sortedVNIs(vnis map[uint32]bool) []uint32 {
+	res := make([]uint32, 0, len(vnis))
+	for vni := range vnis {
+		res = append(res, vni)
+	}
+	sort.Slice(res, func(a, b int) bool {
+		return res[a] < res[b]
+	})
+	return res
+}
+
+func addrEqual(a, b *netip.Addr) bool {
+	var x, y netip.Addr
+	if a != nil {
+		x = *a
+	}
+	if b != nil {
+		y = *b
+	}
+	return x == y
+}
+
+func prefixEqual(a, b *netip.Prefix) bool {
+	var x, y netip.Prefix
+	if a != nil {
+		x = a.Masked()
+	}
+	if b != nil {
+		y = b.Masked()
+	}
+	return x == y
+}
+
+func interfaceEqual(cur *api.Interface, want *api.Interface) bool {
+	return cur.Spec.VNI == want.Spec.VNI &&
+		cur.Spec.Device == want.Spec.Device &&
+		addrEqual(cur.Spec.IPv4, want.Spec.IPv4) &&
+		addrEqual(cur.Spec.IPv6, want.Spec.IPv6)
+}
+
+func natEqual(cur *api.NatSpec, want *api.NatSpec) bool {
+	return addrEqual(cur.NatIP, want.NatIP) &&
+		cur.MinPort == want.MinPort &&
+		cur.MaxPort == want.MaxPort
+}
+
+func routeEqual(cur *api.RouteSpec, want *api.RouteSpec) bool {
+	var curHop, wantHop api.RouteNextHop
+	if cur.NextHop != nil {
+		curHop = *cur.NextHop
+	}
+	if want.NextHop != nil {
+		wantHop = *want.NextHop
+	}
+	return curHop.VNI == wantHop.VNI && addrEqual(curHop.IP, wantHop.IP)
+}
+
+func loadBalancerEqual(cur *api.LoadBalancerSpec, want *api.LoadBalancerSpec) bool {
+	if cur.VNI != want.VNI || !addrEqual(cur.LbVipIP, want.LbVipIP) || len(cur.Lbports) != len(want.Lbports) {
+		return false
+	}
+	ports := map[api.LBPort]int{}
+	for _, port := range cur.Lbports {
+		ports[port]++
+	}
+	for _, port := range want.Lbports {
+		if ports[port] == 0 {
+			return false
+		}
+		ports[port]--
+	}
+	return true
+}
+
+// checkList turns a non-zero status of a list call into an error, list calls only fail on transport errors.
+func checkList[T interface{ GetStatus() api.Status }](list T, err error) (T, error) {
+	if err != nil {
+		return list, err
+	}
+	if status := list.GetStatus(); status.Code != 0 {
+		return list, errors.NewStatusError(status.Code, status.Message)
+	}
+	return list, nil
+}
+
+func firewallRuleEqual(cur *api.FirewallRuleSpec, want *api.FirewallRuleSpec) bool {
+	curFilter, wantFilter := cur.ProtocolFilter, want.ProtocolFilter
+	if curFilter == nil {
+		curFilter = &dpdkproto.ProtocolFilter{}
+	}
+	if wantFilter == nil {
+		wantFilter = &dpdkproto.ProtocolFilter{}
+	}
+	curDirection, curAction, err := api.NormalizeFirewallRule(cur.TrafficDirection, cur.FirewallAction)
+	if err != nil {
+		return false
+	}
+	wantDirection, wantAction, err := api.NormalizeFirewallRule(want.TrafficDirection, want.FirewallAction)
+	if err != nil {
+		return false
+	}
+	return curDirection == wantDirection && curAction == wantAction &&
+		cur.Priority == want.Priority &&
+		prefixEqual(cur.SourcePrefix, want.SourcePrefix) &&
+		prefixEqual(cur.DestinationPrefix, want.DestinationPrefix) &&
+		proto.Equal(curFilter, wantFilter)
+}
+
+// diffPrefixes returns the prefixes missing in current and the ones not wanted anymore.
+func diffPrefixes(want, current []netip.Prefix) (toCreate, toDelete []netip.Prefix) {
+	currentSet := map[netip.Prefix]bool{}
+	for _, prefix := range current {
+		currentSet[prefix.Masked()] = true
+	}
+	wantSet := map[netip.Prefix]bool{}
+	for _, prefix := range want {
+		wantSet[prefix.Masked()] = true
+		if !currentSet[prefix.Masked()] {
+			toCreate = append(toCreate, prefix)
+		}
+	}
+	for _, prefix := range current {
+		if !wantSet[prefix.Masked()] {
+			toDelete = append(toDelete, prefix)
+		}
+	}
+	return toCreate, toDelete
+}
+
+// diffAddrs returns the addresses missing in current and the ones not wanted anymore.
+func diffAddrs(want, current []netip.Addr) (toCreate, toDelete []netip.Addr) {
+	currentSet := map[netip.Addr]bool{}
+	for _, addr := range current {
+		currentSet[addr] = true
+	}
+	wantSet := map[netip.Addr]bool{}
+	for _, addr := range want {
+		wantSet[addr] = true
+		if !currentSet[addr] {
+			toCreate = append(toCreate, addr)
+		}
+	}
+	for _, addr := range current {
+		if !wantSet[addr] {
+			toDelete = append(toDelete, addr)
+		}
+	}
+	return toCreate, toDelete
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package reconcile converges a dp-service node to a declaratively described state.
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/client"
+)
+
+// NodeState is the desired configuration of a dp-service node.
+type NodeState struct {
+	// Interfaces are all interfaces of the node. Interfaces not listed are deleted.
+	Interfaces []InterfaceState
+	// Routes are the routes per VNI. Routes are reconciled for every VNI listed here
+	// and every VNI used by a desired interface or load balancer, a missing key means no routes.
+	Routes map[uint32][]api.RouteSpec
+	// LoadBalancers are the desired load balancers. dp-service cannot list load balancers,
+	// so load balancers not listed here are left untouched.
+	LoadBalancers []LoadBalancerState
+	// NeighborNats are the desired neighbor NATs. Neighbor NATs can only be listed per NAT IP,
+	// so only the NAT IPs used here or by the NAT of a desired interface are reconciled.
+	NeighborNats []api.NeighborNat
+}
+
+// InterfaceState is the desired configuration of an interface and everything attached to it.
+type InterfaceState struct {
+	// Interface is created as is. Changes of VNI, device or primary IPs recreate the interface.
+	// Spec.Nat and Spec.VIP are ignored, use Nat and VirtualIP instead.
+	Interface            api.Interface
+	Prefixes             []netip.Prefix
+	LoadBalancerPrefixes []netip.Prefix
+	VirtualIP            *netip.Addr
+	Nat                  *api.NatSpec
+	FirewallRules        []api.FirewallRuleSpec
+}
+
+// LoadBalancerState is the desired configuration of a load balancer and its targets.
+type LoadBalancerState struct {
+	// LoadBalancer is created as is. Changes of the spec recreate the load balancer.
+	LoadBalancer api.LoadBalancer
+	Targets      []netip.Addr
+}
+
+// Operation is the kind of change an Action applies.
+type Operation string
+
+const (
+	Create Operation = "create"
+	Delete Operation = "delete"
+)
+
+// Action is a single change needed to converge dp-service to the desired state.
+type Action struct {
+	Operation Operation
+	Object    api.Object
+
+	apply func(ctx context.Context) error
+}
+
+func (a *Action) String() string {
+	return fmt.Sprintf("%s %s %s", a.Operation, a.Object.GetKind(), a.Object.GetName())
+}
+
+// Apply executes the action against dp-service.
+func (a *Action) Apply(ctx context.Context) error {
+	return a.apply(ctx)
+}
+
+type options struct {
+	dryRun bool
+}
+
+// Option configures Reconcile.
+type Option func(*options)
+
+// WithDryRun only plans the actions without applying them.
+func WithDryRun() Option {
+	return func(o *options) {
+		o.dryRun = true
+	}
+}
+
+// Reconcile converges dp-service to desired and returns the actions needed to do so.
+// Deletions are applied before creations, both in dependency order: interfaces before
+// prefixes, virtual IPs, NATs and firewall rules, interfaces and load balancers before
+// routes of their VNI, load balancers before their targets, and the reverse when deleting.
+// Reconcile stops at the first failing action. In dry-run mode the actions are only returned.
+func Reconcile(ctx context.Context, c client.Client, desired *NodeState, opts ...Option) ([]Action, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	actions, err := Plan(ctx, c, desired)
+	if err != nil {
+		return nil, err
+	}
+	if o.dryRun {
+		return actions, nil
+	}
+
+	for i := range actions {
+		if err := actions[i].Apply(ctx); err != nil {
+			return actions, fmt.Errorf("error applying %s: %w", actions[i].String(), err)
+		}
+	}
+	return actions, nil
+}
@@ -0,0 +1,256 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package reconcile
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/client"
+	"github.com/ironcore-dev/dpservice-go/errors"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func addr(s string) *netip.Addr {
+	a := netip.MustParseAddr(s)
+	return &a
+}
+
+// statusClient returns a list of firewall rules with a non-zero status, like dp-service does on failures.
+type statusClient struct {
+	client.Client
+}
+
+func (c statusClient) ListFirewallRules(context.Context, string, ...client.CallOption) (*api.FirewallRuleList, error) {
+	return &api.FirewallRuleList{Status: api.Status{Code: errors.NO_BACKIP, Message: "listing failed"}}, nil
+}
+
+func actionStrings(actions []Action) []string {
+	res := make([]string, 0, len(actions))
+	for i := range actions {
+		res = append(res, actions[i].String())
+	}
+	return res
+}
+
+var _ = Describe("reconcile", func() {
+	var c client.Client
+	var desired *NodeState
+
+	BeforeEach(func(ctx SpecContext) {
+		c = newFakeClient(ctx)
+
+		desired = &NodeState{
+			Interfaces: []InterfaceState{
+				{
+					Interface: api.Interface{
+						InterfaceMeta: api.InterfaceMeta{ID: "vm1"},
+						Spec: api.InterfaceSpec{
+							VNI:    100,
+							Device: "net_tap2",
+							IPv4:   addr("10.200.1.4"),
+							IPv6:   addr("2000:200:1::4"),
+						},
+					},
+					Prefixes:  []netip.Prefix{netip.MustParsePrefix("10.20.30.0/24")},
+					VirtualIP: addr("20.20.20.20"),
+					Nat:       &api.NatSpec{NatIP: addr("10.20.30.40"), MinPort: 100, MaxPort: 200},
+					FirewallRules: []api.FirewallRuleSpec{
+						{
+							RuleID:            "fr1",
+							TrafficDirection:  "ingress",
+							FirewallAction:    "accept",
+							Priority:          1000,
+							SourcePrefix:      ptrPrefix("0.0.0.0/0"),
+							DestinationPrefix: ptrPrefix("10.200.1.4/32"),
+						},
+					},
+				},
+			},
+			Routes: map[uint32][]api.RouteSpec{
+				100: {
+					{
+						Prefix:  ptrPrefix("10.100.3.0/24"),
+						NextHop: &api.RouteNextHop{VNI: 0, IP: addr("fc00:2::64:0:1")},
+					},
+				},
+			},
+			LoadBalancers: []LoadBalancerState{
+				{
+					LoadBalancer: api.LoadBalancer{
+						LoadBalancerMeta: api.LoadBalancerMeta{ID: "lb1"},
+						Spec: api.LoadBalancerSpec{
+							VNI:     100,
+							LbVipIP: addr("10.20.40.50"),
+							Lbports: []api.LBPort{{Protocol: 6, Port: 443}},
+						},
+					},
+					Targets: []netip.Addr{netip.MustParseAddr("ff80::5")},
+				},
+			},
+			NeighborNats: []api.NeighborNat{
+				{
+					NeighborNatMeta: api.NeighborNatMeta{NatIP: addr("10.20.30.40")},
+					Spec: api.NeighborNatSpec{
+						Vni:           100,
+						MinPort:       300,
+						MaxPort:       400,
+						UnderlayRoute: addr("ff80::1"),
+					},
+				},
+			},
+		}
+	})
+
+	It("should create the desired state in dependency order", func(ctx SpecContext) {
+		actions, err := Reconcile(ctx, c, desired)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actionStrings(actions)).To(Equal([]string{
+			"create Interface vm1",
+			"create Prefix 10.20.30.0/24",
+			"create VirtualIP on interface: vm1",
+			"create Nat vm1",
+			"create FirewallRule vm1/fr1",
+			"create LoadBalancer lb1",
+			"create Route 10.100.3.0/24-0",
+			"create LoadBalancerTarget on loadbalancer: lb1",
+			"create NeighborNat 10.20.30.40",
+		}))
+
+		prefixes, err := c.ListPrefixes(ctx, "vm1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(prefixes.Items).To(HaveLen(1))
+
+		By("not planning anything once converged")
+		actions, err = Plan(ctx, c, desired)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actions).To(BeEmpty())
+	})
+
+	It("should only plan actions in dry-run mode", func(ctx SpecContext) {
+		actions, err := Reconcile(ctx, c, desired, WithDryRun())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actions).To(HaveLen(9))
+
+		ifaces, err := c.ListInterfaces(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ifaces.Items).To(BeEmpty())
+	})
+
+	It("should converge changes and delete what is not desired anymore", func(ctx SpecContext) {
+		_, err := Reconcile(ctx, c, desired)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = c.CreateInterface(ctx, &api.Interface{
+			InterfaceMeta: api.InterfaceMeta{ID: "vm2"},
+			Spec: api.InterfaceSpec{
+				VNI:    200,
+				Device: "net_tap3",
+				IPv4:   addr("10.200.1.5"),
+				IPv6:   addr("2000:200:1::5"),
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		desired.Interfaces[0].Prefixes = []netip.Prefix{netip.MustParsePrefix("10.20.31.0/24")}
+		desired.Interfaces[0].VirtualIP = nil
+		desired.Interfaces[0].Nat.MaxPort = 150
+		desired.Interfaces[0].FirewallRules[0].TrafficDirection = "Ingress"
+		desired.Interfaces[0].FirewallRules[0].FirewallAction = "Accept"
+		desired.LoadBalancers[0].Targets = []netip.Addr{netip.MustParseAddr("ff80::6")}
+		desired.NeighborNats = nil
+
+		actions, err := Reconcile(ctx, c, desired)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actionStrings(actions)).To(Equal([]string{
+			"delete NeighborNat 10.20.30.40",
+			"delete LoadBalancerTarget on loadbalancer: lb1",
+			"delete Nat vm1",
+			"delete VirtualIP on interface: vm1",
+			"delete Prefix 10.20.30.0/24",
+			"delete Interface vm2",
+			"create Prefix 10.20.31.0/24",
+			"create Nat vm1",
+			"create LoadBalancerTarget on loadbalancer: lb1",
+		}))
+
+		actions, err = Plan(ctx, c, desired)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actions).To(BeEmpty())
+	})
+
+	It("should recreate an interface with all of its children", func(ctx SpecContext) {
+		_, err := Reconcile(ctx, c, desired)
+		Expect(err).NotTo(HaveOccurred())
+
+		desired.Interfaces[0].Interface.Spec.IPv4 = addr("10.200.1.7")
+
+		actions, err := Reconcile(ctx, c, desired)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actionStrings(actions)).To(Equal([]string{
+			"delete Interface vm1",
+			"create Interface vm1",
+			"create Prefix 10.20.30.0/24",
+			"create VirtualIP on interface: vm1",
+			"create Nat vm1",
+			"create FirewallRule vm1/fr1",
+		}))
+
+		iface, err := c.GetInterface(ctx, "vm1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(iface.Spec.IPv4.String()).To(Equal("10.200.1.7"))
+	})
+
+	It("should recreate the routes of a VNI reset by recreating its load balancer", func(ctx SpecContext) {
+		desired.Interfaces[0].Interface.Spec.VNI = 200
+		_, err := Reconcile(ctx, c, desired)
+		Expect(err).NotTo(HaveOccurred())
+
+		desired.LoadBalancers[0].LoadBalancer.Spec.Lbports = []api.LBPort{{Protocol: 6, Port: 8443}}
+
+		actions, err := Reconcile(ctx, c, desired)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actionStrings(actions)).To(Equal([]string{
+			"delete LoadBalancer lb1",
+			"create LoadBalancer lb1",
+			"create Route 10.100.3.0/24-0",
+			"create LoadBalancerTarget on loadbalancer: lb1",
+		}))
+
+		actions, err = Plan(ctx, c, desired)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actions).To(BeEmpty())
+	})
+
+	It("should stop at the first failing action", func(ctx SpecContext) {
+		desired.Interfaces[0].Interface.Spec.VNI = 200
+		desired.Routes = map[uint32][]api.RouteSpec{
+			300: {{Prefix: ptrPrefix("10.100.3.0/24"), NextHop: &api.RouteNextHop{IP: addr("fc00:2::64:0:1")}}},
+		}
+
+		actions, err := Reconcile(ctx, c, desired)
+		Expect(err).To(MatchError(ContainSubstring("error applying create Route")))
+		Expect(actions).NotTo(BeEmpty())
+
+		targets, err := c.ListLoadBalancerTargets(ctx, "lb1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(targets.Items).To(BeEmpty())
+	})
+
+	It("should fail on a non-zero status of a list call", func(ctx SpecContext) {
+		_, err := Reconcile(ctx, c, desired)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = Reconcile(ctx, statusClient{Client: c}, desired)
+		Expect(err).To(MatchError(ContainSubstring("error listing firewall rules of interface vm1")))
+		Expect(errors.IsStatusErrorCode(err, errors.NO_BACKIP)).To(BeTrue())
+	})
+})
+
+func ptrPrefix(s string) *netip.Prefix {
+	p := netip.MustParsePrefix(s)
+	return &p
+}
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package reconcile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ironcore-dev/dpservice-go/client"
+	"github.com/ironcore-dev/dpservice-go/dpservicetest"
+	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestReconcile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Reconcile Suite")
+}
+
+// newFakeClient returns a client of a fake dp-service, both are initialized for the current spec.
+func newFakeClient(ctx context.Context) client.Client {
+	server := dpservicetest.NewServer()
+	server.Start()
+	DeferCleanup(server.Close)
+
+	conn, err := server.Dial(ctx)
+	Expect(err).NotTo(HaveOccurred())
+	DeferCleanup(conn.Close)
+
+	c := client.NewClient(dpdkproto.NewDPDKironcoreClient(conn))
+	_, err = c.Initialize(ctx)
+	Expect(err).NotTo(HaveOccurred())
+	return c
+}
@@ -0,0 +1,324 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package snapshot exports everything a dp-service node holds and restores it again.
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"sort"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/client"
+	"github.com/ironcore-dev/dpservice-go/errors"
+	"github.com/ironcore-dev/dpservice-go/reconcile"
+	"sigs.k8s.io/yaml"
+)
+
+// Version is the format version written to and expected in serialized snapshots.
+const Version = "v1"
+
+// NodeSnapshot is the state of a dp-service node.
+type NodeSnapshot struct {
+	Version string `json:"version"`
+	// UUID identifies the dp-service run the snapshot was taken from.
+	UUID          string                     `json:"uuid,omitempty"`
+	Interfaces    []InterfaceSnapshot        `json:"interfaces,omitempty"`
+	Routes        map[uint32][]api.RouteSpec `json:"routes,omitempty"`
+	LoadBalancers []LoadBalancerSnapshot     `json:"loadbalancers,omitempty"`
+	NeighborNats  []NeighborNatSnapshot      `json:"neighbor_nats,omitempty"`
+}
+
+// InterfaceSnapshot is an interface and everything attached to it.
+type InterfaceSnapshot struct {
+	ID                   string                 `json:"id"`
+	Spec                 api.InterfaceSpec      `json:"spec"`
+	Prefixes             []netip.Prefix         `json:"prefixes,omitempty"`
+	LoadBalancerPrefixes []netip.Prefix         `json:"loadbalancer_prefixes,omitempty"`
+	VirtualIP            *netip.Addr            `json:"virtual_ip,omitempty"`
+	Nat                  *api.NatSpec           `json:"nat,omitempty"`
+	FirewallRules        []api.FirewallRuleSpec `json:"firewall_rules,omitempty"`
+}
+
+// LoadBalancerSnapshot is a load balancer and its targets.
+type LoadBalancerSnapshot struct {
+	ID      string               `json:"id"`
+	Spec    api.LoadBalancerSpec `json:"spec"`
+	Targets []netip.Addr         `json:"targets,omitempty"`
+}
+
+// NeighborNatSnapshot is a NAT range of a NAT IP handled by another node.
+type NeighborNatSnapshot struct {
+	NatIP netip.Addr          `json:"nat_ip"`
+	Spec  api.NeighborNatSpec `json:"spec"`
+}
+
+type options struct {
+	loadBalancerIDs []string
+	natIPs          []netip.Addr
+}
+
+// Option configures Snapshot.
+type Option func(*options)
+
+// WithLoadBalancers includes the given load balancers in the snapshot.
+// dp-service cannot list load balancers, so only the ones given here are exported.
+func WithLoadBalancers(ids ...string) Option {
+	return func(o *options) {
+		o.loadBalancerIDs = append(o.loadBalancerIDs, ids...)
+	}
+}
+
+// WithNatIPs includes the neighbor NATs of the given NAT IPs in the snapshot,
+// in addition to the ones of the NAT IPs used by interfaces.
+func WithNatIPs(ips ...netip.Addr) Option {
+	return func(o *options) {
+		o.natIPs = append(o.natIPs, ips...)
+	}
+}
+
+// Snapshot exports the interfaces with their prefixes, load balancer prefixes, virtual IPs,
+// NATs and firewall rules, the routes of all VNIs in use, the requested load balancers and
+// the neighbor NATs of all NAT IPs in use.
+func Snapshot(ctx context.Context, c client.Client, opts ...Option) (*NodeSnapshot, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	init, err := c.CheckInitialized(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error checking initialization: %w", err)
+	}
+	snap := &NodeSnapshot{
+		Version: Version,
+		UUID:    init.Spec.UUID,
+		Routes:  map[uint32][]api.RouteSpec{},
+	}
+
+	vnis := map[uint32]bool{}
+	natIPs := map[netip.Addr]bool{}
+	for _, ip := range o.natIPs {
+		natIPs[ip] = true
+	}
+
+	ifaces, err := checkList(c.ListInterfaces(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error listing interfaces: %w", err)
+	}
+	for _, iface := range ifaces.Items {
+		ifaceSnap, err := snapshotInterface(ctx, c, &iface)
+		if err != nil {
+			return nil, err
+		}
+		snap.Interfaces = append(snap.Interfaces, *ifaceSnap)
+
+		vnis[iface.Spec.VNI] = true
+		if ifaceSnap.Nat != nil && ifaceSnap.Nat.NatIP != nil {
+			natIPs[*ifaceSnap.Nat.NatIP] = true
+		}
+	}
+
+	for _, id := range o.loadBalancerIDs {
+		lb, err := c.GetLoadBalancer(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("error getting loadbalancer %s: %w", id, err)
+		}
+		targets, err := checkList(c.ListLoadBalancerTargets(ctx, id))
+		if err != nil {
+			return nil, fmt.Errorf("error listing targets of loadbalancer %s: %w", id, err)
+		}
+		lbSnap := LoadBalancerSnapshot{ID: id, Spec: lb.Spec}
+		for _, target := range targets.Items {
+			if target.Spec.TargetIP != nil {
+				lbSnap.Targets = append(lbSnap.Targets, *target.Spec.TargetIP)
+			}
+		}
+		snap.LoadBalancers = append(snap.LoadBalancers, lbSnap)
+
+		vnis[lb.Spec.VNI] = true
+	}
+
+	for vni := range vnis {
+		routes, err := c.ListRoutes(ctx, vni)
+		// dp-service reports NO_VNI for a VNI it holds nothing for, which has no routes.
+		if err == nil && routes.Status.Code == errors.NO_VNI {
+			routes = &api.RouteList{}
+		}
+		routes, err = checkList(routes, err)
+		if err != nil {
+			return nil, fmt.Errorf("error listing routes of vni %d: %w", vni, err)
+		}
+		for _, route := range routes.Items {
+			snap.Routes[vni] = append(snap.Routes[vni], route.Spec)
+		}
+	}
+
+	ips := make([]netip.Addr, 0, len(natIPs))
+	for ip := range natIPs {
+		ips = append(ips, ip)
+	}
+	sort.Slice(ips, func(i, j int) bool {
+		return ips[i].Less(ips[j])
+	})
+	for _, ip := range ips {
+		ip := ip
+		nats, err := checkList(c.ListNeighborNats(ctx, &ip))
+		if err != nil {
+			return nil, fmt.Errorf("error listing neighbor nats of %s: %w", ip, err)
+		}
+		for _, nat := range nats.Items {
+			snap.NeighborNats = append(snap.NeighborNats, NeighborNatSnapshot{
+				NatIP: ip,
+				Spec: api.NeighborNatSpec{
+					Vni:           nat.Spec.Vni,
+					MinPort:       nat.Spec.MinPort,
+					MaxPort:       nat.Spec.MaxPort,
+					UnderlayRoute: nat.Spec.UnderlayRoute,
+				},
+			})
+		}
+	}
+
+	return snap, nil
+}
+
+func snapshotInterface(ctx context.Context, c client.Client, iface *api.Interface) (*InterfaceSnapshot, error) {
+	id := iface.ID
+	snap := &InterfaceSnapshot{ID: id, Spec: iface.Spec}
+	snap.Spec.Nat = nil
+	snap.Spec.VIP = nil
+
+	prefixes, err := checkList(c.ListPrefixes(ctx, id))
+	if err != nil {
+		return nil, fmt.Errorf("error listing prefixes of interface %s: %w", id, err)
+	}
+	for _, prefix := range prefixes.Items {
+		snap.Prefixes = append(snap.Prefixes, prefix.Spec.Prefix)
+	}
+
+	lbPrefixes, err := checkList(c.ListLoadBalancerPrefixes(ctx, id))
+	if err != nil {
+		return nil, fmt.Errorf("error listing loadbalancer prefixes of interface %s: %w", id, err)
+	}
+	for _, prefix := range lbPrefixes.Items {
+		snap.LoadBalancerPrefixes = append(snap.LoadBalancerPrefixes, prefix.Spec.Prefix)
+	}
+
+	vip, err := c.GetVirtualIP(ctx, id, client.IgnoreErrors(errors.SNAT_NO_DATA))
+	if err != nil {
+		return nil, fmt.Errorf("error getting virtual ip of interface %s: %w", id, err)
+	}
+	if vip.Status.Code == 0 {
+		snap.VirtualIP = vip.Spec.IP
+	}
+
+	nat, err := c.GetNat(ctx, id, client.IgnoreErrors(errors.SNAT_NO_DATA))
+	if err != nil {
+		return nil, fmt.Errorf("error getting nat of interface %s: %w", id, err)
+	}
+	if nat.Status.Code == 0 {
+		snap.Nat = &api.NatSpec{NatIP: nat.Spec.NatIP, MinPort: nat.Spec.MinPort, MaxPort: nat.Spec.MaxPort}
+	}
+
+	rules, err := checkList(c.ListFirewallRules(ctx, id))
+	if err != nil {
+		return nil, fmt.Errorf("error listing firewall rules of interface %s: %w", id, err)
+	}
+	for _, rule := range rules.Items {
+		snap.FirewallRules = append(snap.FirewallRules, rule.Spec)
+	}
+
+	return snap, nil
+}
+
+// checkList turns a non-zero status of a list call into an error. List calls only fail on transport
+// errors, a snapshot missing the objects of a failed list would delete them on Restore.
+func checkList[T interface{ GetStatus() api.Status }](list T, err error) (T, error) {
+	if err != nil {
+		return list, err
+	}
+	if status := list.GetStatus(); status.Code != 0 {
+		return list, errors.NewStatusError(status.Code, status.Message)
+	}
+	return list, nil
+}
+
+// NodeState returns the snapshot as desired state for the reconcile package.
+func (s *NodeSnapshot) NodeState() *reconcile.NodeState {
+	state := &reconcile.NodeState{Routes: s.Routes}
+	for _, iface := range s.Interfaces {
+		spec := iface.Spec
+		spec.UnderlayRoute = nil
+		spec.VirtualFunction = nil
+		state.Interfaces = append(state.Interfaces, reconcile.InterfaceState{
+			Interface: api.Interface{
+				InterfaceMeta: api.InterfaceMeta{ID: iface.ID},
+				Spec:          spec,
+			},
+			Prefixes:             iface.Prefixes,
+			LoadBalancerPrefixes: iface.LoadBalancerPrefixes,
+			VirtualIP:            iface.VirtualIP,
+			Nat:                  iface.Nat,
+			FirewallRules:        iface.FirewallRules,
+		})
+	}
+	for _, lb := range s.LoadBalancers {
+		spec := lb.Spec
+		spec.UnderlayRoute = nil
+		state.LoadBalancers = append(state.LoadBalancers, reconcile.LoadBalancerState{
+			LoadBalancer: api.LoadBalancer{
+				LoadBalancerMeta: api.LoadBalancerMeta{ID: lb.ID},
+				Spec:             spec,
+			},
+			Targets: lb.Targets,
+		})
+	}
+	for _, nat := range s.NeighborNats {
+		natIP := nat.NatIP
+		state.NeighborNats = append(state.NeighborNats, api.NeighborNat{
+			NeighborNatMeta: api.NeighborNatMeta{NatIP: &natIP},
+			Spec:            nat.Spec,
+		})
+	}
+	return state
+}
+
+// Restore replays the snapshot into dp-service, which has to be initialized already.
+// Interfaces not contained in the snapshot are deleted, so restoring into a node that
+// is already configured converges it to the snapshot.
+// Underlay routes and virtual functions are assigned by dp-service and may differ afterwards.
+func Restore(ctx context.Context, c client.Client, snap *NodeSnapshot) error {
+	if snap.Version != Version {
+		return fmt.Errorf("unsupported snapshot version %q", snap.Version)
+	}
+	if _, err := reconcile.Reconcile(ctx, c, snap.NodeState()); err != nil {
+		return fmt.Errorf("error restoring snapshot: %w", err)
+	}
+	return nil
+}
+
+// EncodeJSON encodes the snapshot as indented JSON.
+func (s *NodeSnapshot) EncodeJSON() ([]byte, error) {
+	return json.MarshalIndent(s, "", "  ")
+}
+
+// EncodeYAML encodes the snapshot as YAML.
+func (s *NodeSnapshot) EncodeYAML() ([]byte, error) {
+	return yaml.Marshal(s)
+}
+
+// Decode decodes a JSON or YAML encoded snapshot and checks its version.
+func Decode(data []byte) (*NodeSnapshot, error) {
+	snap := &NodeSnapshot{}
+	if err := yaml.Unmarshal(data, snap); err != nil {
+		return nil, fmt.Errorf("error decoding snapshot: %w", err)
+	}
+	if snap.Version != Version {
+		return nil, fmt.Errorf("unsupported snapshot version %q", snap.Version)
+	}
+	return snap, nil
+}
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/client"
+	"github.com/ironcore-dev/dpservice-go/dpservicetest"
+	"github.com/ironcore-dev/dpservice-go/errors"
+	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func addr(s string) *netip.Addr {
+	a := netip.MustParseAddr(s)
+	return &a
+}
+
+func prefix(s string) *netip.Prefix {
+	p := netip.MustParsePrefix(s)
+	return &p
+}
+
+// statusClient lists prefixes with a non-zero status, like dp-service does on failures.
+type statusClient struct {
+	client.Client
+}
+
+func (c statusClient) ListPrefixes(context.Context, string, ...client.CallOption) (*api.PrefixList, error) {
+	return &api.PrefixList{Status: api.Status{Code: errors.NO_VM, Message: "listing failed"}}, nil
+}
+
+var _ = Describe("snapshot", func() {
+	var server *dpservicetest.Server
+	var c client.Client
+
+	BeforeEach(func(ctx SpecContext) {
+		server, c = newFakeServer(ctx)
+
+		_, err := c.CreateInterface(ctx, &api.Interface{
+			InterfaceMeta: api.InterfaceMeta{ID: "vm1"},
+			Spec:          api.InterfaceSpec{VNI: 100, Device: "net_tap2", IPv4: addr("10.200.1.4"), IPv6: addr("2000:200:1::4")},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.CreatePrefix(ctx, &api.Prefix{
+			PrefixMeta: api.PrefixMeta{InterfaceID: "vm1"},
+			Spec:       api.PrefixSpec{Prefix: *prefix("10.20.30.0/24")},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.CreateNat(ctx, &api.Nat{
+			NatMeta: api.NatMeta{InterfaceID: "vm1"},
+			Spec:    api.NatSpec{NatIP: addr("10.20.30.40"), MinPort: 100, MaxPort: 200},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.CreateFirewallRule(ctx, &api.FirewallRule{
+			FirewallRuleMeta: api.FirewallRuleMeta{InterfaceID: "vm1"},
+			Spec: api.FirewallRuleSpec{
+				RuleID:            "fr1",
+				TrafficDirection:  "Ingress",
+				FirewallAction:    "Accept",
+				Priority:          1000,
+				SourcePrefix:      prefix("0.0.0.0/0"),
+				DestinationPrefix: prefix("10.200.1.4/32"),
+				ProtocolFilter: &dpdkproto.ProtocolFilter{Filter: &dpdkproto.ProtocolFilter_Tcp{Tcp: &dpdkproto.TcpFilter{
+					SrcPortLower: 1, SrcPortUpper: 1000, DstPortLower: 500, DstPortUpper: 600,
+				}}},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.CreateRoute(ctx, &api.Route{
+			RouteMeta: api.RouteMeta{VNI: 100},
+			Spec:      api.RouteSpec{Prefix: prefix("10.100.3.0/24"), NextHop: &api.RouteNextHop{IP: addr("fc00:2::64:0:1")}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.CreateLoadBalancer(ctx, &api.LoadBalancer{
+			LoadBalancerMeta: api.LoadBalancerMeta{ID: "lb1"},
+			Spec:             api.LoadBalancerSpec{VNI: 100, LbVipIP: addr("10.20.40.50"), Lbports: []api.LBPort{{Protocol: 6, Port: 443}}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.CreateLoadBalancerTarget(ctx, &api.LoadBalancerTarget{
+			LoadBalancerTargetMeta: api.LoadBalancerTargetMeta{LoadbalancerID: "lb1"},
+			Spec:                   api.LoadBalancerTargetSpec{TargetIP: addr("ff80::5")},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.CreateNeighborNat(ctx, &api.NeighborNat{
+			NeighborNatMeta: api.NeighborNatMeta{NatIP: addr("10.20.30.40")},
+			Spec:            api.NeighborNatSpec{Vni: 100, MinPort: 300, MaxPort: 400, UnderlayRoute: addr("ff80::1")},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should export the node and restore it after a restart", func(ctx SpecContext) {
+		snap, err := Snapshot(ctx, c, WithLoadBalancers("lb1"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(snap.Version).To(Equal(Version))
+		Expect(snap.Interfaces).To(HaveLen(1))
+		Expect(snap.Interfaces[0].Prefixes).To(ConsistOf(*prefix("10.20.30.0/24")))
+		Expect(snap.Interfaces[0].FirewallRules).To(HaveLen(1))
+		Expect(snap.Routes[100]).To(HaveLen(1))
+		Expect(snap.LoadBalancers[0].Targets).To(ConsistOf(*addr("ff80::5")))
+		Expect(snap.NeighborNats).To(HaveLen(1))
+
+		By("surviving a round trip through yaml")
+		data, err := snap.EncodeYAML()
+		Expect(err).NotTo(HaveOccurred())
+		decoded, err := Decode(data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decoded.Interfaces[0].FirewallRules[0].ProtocolFilter.GetTcp().GetDstPortUpper()).To(Equal(int32(600)))
+
+		By("restoring it into a fresh dp-service")
+		server.Restart()
+		_, err = c.Initialize(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(Restore(ctx, c, decoded)).To(Succeed())
+
+		restored, err := Snapshot(ctx, c, WithLoadBalancers("lb1"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restored.UUID).NotTo(Equal(snap.UUID))
+		Expect(restored.Interfaces[0].Prefixes).To(Equal(snap.Interfaces[0].Prefixes))
+		Expect(restored.Interfaces[0].Nat).To(Equal(snap.Interfaces[0].Nat))
+		Expect(restored.Routes).To(Equal(snap.Routes))
+		Expect(restored.LoadBalancers[0].Targets).To(Equal(snap.LoadBalancers[0].Targets))
+		Expect(restored.NeighborNats).To(Equal(snap.NeighborNats))
+	})
+
+	It("should fail if a list call returns a status", func(ctx SpecContext) {
+		_, err := Snapshot(ctx, statusClient{Client: c})
+		Expect(err).To(MatchError(ContainSubstring("error listing prefixes of interface vm1")))
+		Expect(errors.IsStatusErrorCode(err, errors.NO_VM)).To(BeTrue())
+	})
+
+	It("should reject snapshots of an unknown version", func() {
+		data, err := (&NodeSnapshot{Version: "v0"}).EncodeJSON()
+		Expect(err).NotTo(HaveOccurred())
+		_, err = Decode(data)
+		Expect(err).To(MatchError(ContainSubstring("unsupported snapshot version")))
+	})
+})
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ironcore-dev/dpservice-go/client"
+	"github.com/ironcore-dev/dpservice-go/dpservicetest"
+	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSnapshot(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Snapshot Suite")
+}
+
+// newFakeServer starts a fake dp-service for the current spec and returns it with an initialized client,
+// the server is returned as well so specs can restart it.
+func newFakeServer(ctx context.Context) (*dpservicetest.Server, client.Client) {
+	server := dpservicetest.NewServer()
+	server.Start()
+	DeferCleanup(server.Close)
+
+	conn, err := server.Dial(ctx)
+	Expect(err).NotTo(HaveOccurred())
+	DeferCleanup(conn.Close)
+
+	c := client.NewClient(dpdkproto.NewDPDKironcoreClient(conn))
+	_, err = c.Initialize(ctx)
+	Expect(err).NotTo(HaveOccurred())
+	return server, c
+}
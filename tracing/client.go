@@ -0,0 +1,312 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/client"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type tracedClient struct {
+	client.Client
+	tracer trace.Tracer
+}
+
+func (c *tracedClient) GetLoadBalancer(ctx context.Context, id string, opts ...client.CallOption) (*api.LoadBalancer, error) {
+	ctx, span := c.start(ctx, "GetLoadBalancer", LoadBalancerIDKey.String(id))
+	res, err := c.Client.GetLoadBalancer(ctx, id, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) CreateLoadBalancer(ctx context.Context, lb *api.LoadBalancer, opts ...client.CallOption) (*api.LoadBalancer, error) {
+	ctx, span := c.start(ctx, "CreateLoadBalancer", objectAttributes(lb)...)
+	res, err := c.Client.CreateLoadBalancer(ctx, lb, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) DeleteLoadBalancer(ctx context.Context, id string, opts ...client.CallOption) (*api.LoadBalancer, error) {
+	ctx, span := c.start(ctx, "DeleteLoadBalancer", LoadBalancerIDKey.String(id))
+	res, err := c.Client.DeleteLoadBalancer(ctx, id, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) ListLoadBalancerPrefixes(ctx context.Context, interfaceID string, opts ...client.CallOption) (*api.PrefixList, error) {
+	ctx, span := c.start(ctx, "ListLoadBalancerPrefixes", InterfaceIDKey.String(interfaceID))
+	res, err := c.Client.ListLoadBalancerPrefixes(ctx, interfaceID, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) CreateLoadBalancerPrefix(ctx context.Context, prefix *api.LoadBalancerPrefix, opts ...client.CallOption) (*api.LoadBalancerPrefix, error) {
+	ctx, span := c.start(ctx, "CreateLoadBalancerPrefix", objectAttributes(prefix)...)
+	res, err := c.Client.CreateLoadBalancerPrefix(ctx, prefix, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) DeleteLoadBalancerPrefix(ctx context.Context, interfaceID string, prefix *netip.Prefix, opts ...client.CallOption) (*api.LoadBalancerPrefix, error) {
+	ctx, span := c.start(ctx, "DeleteLoadBalancerPrefix", InterfaceIDKey.String(interfaceID), prefixAttribute(prefix))
+	res, err := c.Client.DeleteLoadBalancerPrefix(ctx, interfaceID, prefix, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) ListLoadBalancerTargets(ctx context.Context, interfaceID string, opts ...client.CallOption) (*api.LoadBalancerTargetList, error) {
+	ctx, span := c.start(ctx, "ListLoadBalancerTargets", LoadBalancerIDKey.String(interfaceID))
+	res, err := c.Client.ListLoadBalancerTargets(ctx, interfaceID, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) CreateLoadBalancerTarget(ctx context.Context, lbtarget *api.LoadBalancerTarget, opts ...client.CallOption) (*api.LoadBalancerTarget, error) {
+	ctx, span := c.start(ctx, "CreateLoadBalancerTarget", objectAttributes(lbtarget)...)
+	res, err := c.Client.CreateLoadBalancerTarget(ctx, lbtarget, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) DeleteLoadBalancerTarget(ctx context.Context, id string, targetIP *netip.Addr, opts ...client.CallOption) (*api.LoadBalancerTarget, error) {
+	ctx, span := c.start(ctx, "DeleteLoadBalancerTarget", LoadBalancerIDKey.String(id), targetIPAttribute(targetIP))
+	res, err := c.Client.DeleteLoadBalancerTarget(ctx, id, targetIP, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) GetInterface(ctx context.Context, id string, opts ...client.CallOption) (*api.Interface, error) {
+	ctx, span := c.start(ctx, "GetInterface", InterfaceIDKey.String(id))
+	res, err := c.Client.GetInterface(ctx, id, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) ListInterfaces(ctx context.Context, opts ...client.CallOption) (*api.InterfaceList, error) {
+	ctx, span := c.start(ctx, "ListInterfaces")
+	res, err := c.Client.ListInterfaces(ctx, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) CreateInterface(ctx context.Context, iface *api.Interface, opts ...client.CallOption) (*api.Interface, error) {
+	ctx, span := c.start(ctx, "CreateInterface", objectAttributes(iface)...)
+	res, err := c.Client.CreateInterface(ctx, iface, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) DeleteInterface(ctx context.Context, id string, opts ...client.CallOption) (*api.Interface, error) {
+	ctx, span := c.start(ctx, "DeleteInterface", InterfaceIDKey.String(id))
+	res, err := c.Client.DeleteInterface(ctx, id, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) GetVirtualIP(ctx context.Context, interfaceID string, opts ...client.CallOption) (*api.VirtualIP, error) {
+	ctx, span := c.start(ctx, "GetVirtualIP", InterfaceIDKey.String(interfaceID))
+	res, err := c.Client.GetVirtualIP(ctx, interfaceID, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) CreateVirtualIP(ctx context.Context, virtualIP *api.VirtualIP, opts ...client.CallOption) (*api.VirtualIP, error) {
+	ctx, span := c.start(ctx, "CreateVirtualIP", objectAttributes(virtualIP)...)
+	res, err := c.Client.CreateVirtualIP(ctx, virtualIP, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) DeleteVirtualIP(ctx context.Context, interfaceID string, opts ...client.CallOption) (*api.VirtualIP, error) {
+	ctx, span := c.start(ctx, "DeleteVirtualIP", InterfaceIDKey.String(interfaceID))
+	res, err := c.Client.DeleteVirtualIP(ctx, interfaceID, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) ListPrefixes(ctx context.Context, interfaceID string, opts ...client.CallOption) (*api.PrefixList, error) {
+	ctx, span := c.start(ctx, "ListPrefixes", InterfaceIDKey.String(interfaceID))
+	res, err := c.Client.ListPrefixes(ctx, interfaceID, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) CreatePrefix(ctx context.Context, prefix *api.Prefix, opts ...client.CallOption) (*api.Prefix, error) {
+	ctx, span := c.start(ctx, "CreatePrefix", objectAttributes(prefix)...)
+	res, err := c.Client.CreatePrefix(ctx, prefix, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) DeletePrefix(ctx context.Context, interfaceID string, prefix *netip.Prefix, opts ...client.CallOption) (*api.Prefix, error) {
+	ctx, span := c.start(ctx, "DeletePrefix", InterfaceIDKey.String(interfaceID), prefixAttribute(prefix))
+	res, err := c.Client.DeletePrefix(ctx, interfaceID, prefix, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) ListRoutes(ctx context.Context, vni uint32, opts ...client.CallOption) (*api.RouteList, error) {
+	ctx, span := c.start(ctx, "ListRoutes", VNIKey.Int64(int64(vni)))
+	res, err := c.Client.ListRoutes(ctx, vni, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) CreateRoute(ctx context.Context, route *api.Route, opts ...client.CallOption) (*api.Route, error) {
+	ctx, span := c.start(ctx, "CreateRoute", objectAttributes(route)...)
+	res, err := c.Client.CreateRoute(ctx, route, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) DeleteRoute(ctx context.Context, vni uint32, prefix *netip.Prefix, opts ...client.CallOption) (*api.Route, error) {
+	ctx, span := c.start(ctx, "DeleteRoute", VNIKey.Int64(int64(vni)), prefixAttribute(prefix))
+	res, err := c.Client.DeleteRoute(ctx, vni, prefix, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) GetNat(ctx context.Context, interfaceID string, opts ...client.CallOption) (*api.Nat, error) {
+	ctx, span := c.start(ctx, "GetNat", InterfaceIDKey.String(interfaceID))
+	res, err := c.Client.GetNat(ctx, interfaceID, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) CreateNat(ctx context.Context, nat *api.Nat, opts ...client.CallOption) (*api.Nat, error) {
+	ctx, span := c.start(ctx, "CreateNat", objectAttributes(nat)...)
+	res, err := c.Client.CreateNat(ctx, nat, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) DeleteNat(ctx context.Context, interfaceID string, opts ...client.CallOption) (*api.Nat, error) {
+	ctx, span := c.start(ctx, "DeleteNat", InterfaceIDKey.String(interfaceID))
+	res, err := c.Client.DeleteNat(ctx, interfaceID, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) ListLocalNats(ctx context.Context, natIP *netip.Addr, opts ...client.CallOption) (*api.NatList, error) {
+	ctx, span := c.start(ctx, "ListLocalNats", natIPAttribute(natIP))
+	res, err := c.Client.ListLocalNats(ctx, natIP, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) CreateNeighborNat(ctx context.Context, nat *api.NeighborNat, opts ...client.CallOption) (*api.NeighborNat, error) {
+	ctx, span := c.start(ctx, "CreateNeighborNat", objectAttributes(nat)...)
+	res, err := c.Client.CreateNeighborNat(ctx, nat, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) ListNats(ctx context.Context, natIP *netip.Addr, natType string, opts ...client.CallOption) (*api.NatList, error) {
+	ctx, span := c.start(ctx, "ListNats", natIPAttribute(natIP))
+	res, err := c.Client.ListNats(ctx, natIP, natType, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) DeleteNeighborNat(ctx context.Context, neigbhorNat *api.NeighborNat, opts ...client.CallOption) (*api.NeighborNat, error) {
+	ctx, span := c.start(ctx, "DeleteNeighborNat", objectAttributes(neigbhorNat)...)
+	res, err := c.Client.DeleteNeighborNat(ctx, neigbhorNat, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) ListNeighborNats(ctx context.Context, natIP *netip.Addr, opts ...client.CallOption) (*api.NatList, error) {
+	ctx, span := c.start(ctx, "ListNeighborNats", natIPAttribute(natIP))
+	res, err := c.Client.ListNeighborNats(ctx, natIP, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) ListFirewallRules(ctx context.Context, interfaceID string, opts ...client.CallOption) (*api.FirewallRuleList, error) {
+	ctx, span := c.start(ctx, "ListFirewallRules", InterfaceIDKey.String(interfaceID))
+	res, err := c.Client.ListFirewallRules(ctx, interfaceID, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) CreateFirewallRule(ctx context.Context, fwRule *api.FirewallRule, opts ...client.CallOption) (*api.FirewallRule, error) {
+	ctx, span := c.start(ctx, "CreateFirewallRule", objectAttributes(fwRule)...)
+	res, err := c.Client.CreateFirewallRule(ctx, fwRule, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) GetFirewallRule(ctx context.Context, interfaceID string, ruleID string, opts ...client.CallOption) (*api.FirewallRule, error) {
+	ctx, span := c.start(ctx, "GetFirewallRule", InterfaceIDKey.String(interfaceID), FirewallRuleIDKey.String(ruleID))
+	res, err := c.Client.GetFirewallRule(ctx, interfaceID, ruleID, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) DeleteFirewallRule(ctx context.Context, interfaceID string, ruleID string, opts ...client.CallOption) (*api.FirewallRule, error) {
+	ctx, span := c.start(ctx, "DeleteFirewallRule", InterfaceIDKey.String(interfaceID), FirewallRuleIDKey.String(ruleID))
+	res, err := c.Client.DeleteFirewallRule(ctx, interfaceID, ruleID, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) CheckInitialized(ctx context.Context, opts ...client.CallOption) (*api.Initialized, error) {
+	ctx, span := c.start(ctx, "CheckInitialized")
+	res, err := c.Client.CheckInitialized(ctx, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) Initialize(ctx context.Context, opts ...client.CallOption) (*api.Initialized, error) {
+	ctx, span := c.start(ctx, "Initialize")
+	res, err := c.Client.Initialize(ctx, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) GetVni(ctx context.Context, vni uint32, vniType uint8, opts ...client.CallOption) (*api.Vni, error) {
+	ctx, span := c.start(ctx, "GetVni", VNIKey.Int64(int64(vni)))
+	res, err := c.Client.GetVni(ctx, vni, vniType, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) ResetVni(ctx context.Context, vni uint32, vniType uint8, opts ...client.CallOption) (*api.Vni, error) {
+	ctx, span := c.start(ctx, "ResetVni", VNIKey.Int64(int64(vni)))
+	res, err := c.Client.ResetVni(ctx, vni, vniType, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) GetVersion(ctx context.Context, version *api.Version, opts ...client.CallOption) (*api.Version, error) {
+	ctx, span := c.start(ctx, "GetVersion", objectAttributes(version)...)
+	res, err := c.Client.GetVersion(ctx, version, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) CaptureStart(ctx context.Context, capture *api.CaptureStart, opts ...client.CallOption) (*api.CaptureStart, error) {
+	ctx, span := c.start(ctx, "CaptureStart", objectAttributes(capture)...)
+	res, err := c.Client.CaptureStart(ctx, capture, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) CaptureStop(ctx context.Context, opts ...client.CallOption) (*api.CaptureStop, error) {
+	ctx, span := c.start(ctx, "CaptureStop")
+	res, err := c.Client.CaptureStop(ctx, opts...)
+	end(span, res, err)
+	return res, err
+}
+
+func (c *tracedClient) CaptureStatus(ctx context.Context, opts ...client.CallOption) (*api.CaptureStatus, error) {
+	ctx, span := c.start(ctx, "CaptureStatus")
+	res, err := c.Client.CaptureStatus(ctx, opts...)
+	end(span, res, err)
+	return res, err
+}
@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tracing records OpenTelemetry spans of the calls to dp-service. Spans carry the
+// interface ID, VNI, prefix and NAT IP of the call and the status dp-service returned,
+// which makes the RPCs of composite operations like client.AttachVM visible.
+package tracing
+
+import (
+	"context"
+	stderrors "errors"
+	"net/netip"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/client"
+	"github.com/ironcore-dev/dpservice-go/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const instrumentationName = "github.com/ironcore-dev/dpservice-go/tracing"
+
+// Attribute keys of the spans.
+const (
+	InterfaceIDKey    = attribute.Key("dpservice.interface_id")
+	VNIKey            = attribute.Key("dpservice.vni")
+	PrefixKey         = attribute.Key("dpservice.prefix")
+	NatIPKey          = attribute.Key("dpservice.nat_ip")
+	LoadBalancerIDKey = attribute.Key("dpservice.loadbalancer_id")
+	TargetIPKey       = attribute.Key("dpservice.target_ip")
+	FirewallRuleIDKey = attribute.Key("dpservice.firewall_rule_id")
+	StatusCodeKey     = attribute.Key("dpservice.status.code")
+	StatusMessageKey  = attribute.Key("dpservice.status.message")
+)
+
+type options struct {
+	tracerProvider trace.TracerProvider
+	propagator     propagation.TextMapPropagator
+}
+
+// Option configures WrapClient and UnaryClientInterceptor.
+type Option func(*options)
+
+// WithTracerProvider sets the provider of the tracer, otel.GetTracerProvider() by default.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(o *options) {
+		o.tracerProvider = provider
+	}
+}
+
+// WithPropagator sets the propagator injecting the trace context, otel.GetTextMapPropagator() by default.
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(o *options) {
+		o.propagator = propagator
+	}
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{
+		tracerProvider: otel.GetTracerProvider(),
+		propagator:     otel.GetTextMapPropagator(),
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WrapClient returns a Client recording a span for every call, named after the Client method, e.g. dpservice.CreateInterface.
+// Spans are marked as errors when dp-service returns a status code that was not ignored or the call failed otherwise.
+func WrapClient(c client.Client, opts ...Option) client.Client {
+	o := newOptions(opts)
+	return &tracedClient{Client: c, tracer: o.tracerProvider.Tracer(instrumentationName)}
+}
+
+func (c *tracedClient) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	valid := attrs[:0]
+	for _, attr := range attrs {
+		if attr.Valid() {
+			valid = append(valid, attr)
+		}
+	}
+	return c.tracer.Start(ctx, "dpservice."+method, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(valid...))
+}
+
+// end records the status of the call and ends the span. Status codes ignored with client.IgnoreErrors
+// are taken from the status of the returned object and do not mark the span as error.
+func end[T any](span trace.Span, res T, err error) {
+	defer span.End()
+
+	if obj, ok := any(res).(interface{ GetStatus() api.Status }); ok && err == nil {
+		if status := obj.GetStatus(); status.Code != 0 {
+			span.SetAttributes(StatusCodeKey.Int64(int64(status.Code)), StatusMessageKey.String(status.Message))
+		}
+	}
+
+	statusErr := &errors.StatusError{}
+	switch {
+	case stderrors.As(err, &statusErr):
+		span.SetAttributes(StatusCodeKey.Int64(int64(statusErr.ErrorCode())), StatusMessageKey.String(statusErr.Message()))
+		span.SetStatus(codes.Error, statusErr.Error())
+	case err != nil:
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+func prefixAttribute(prefix *netip.Prefix) attribute.KeyValue {
+	if prefix == nil {
+		return attribute.KeyValue{}
+	}
+	return PrefixKey.String(prefix.String())
+}
+
+func natIPAttribute(ip *netip.Addr) attribute.KeyValue {
+	if ip == nil {
+		return attribute.KeyValue{}
+	}
+	return NatIPKey.String(ip.String())
+}
+
+func targetIPAttribute(ip *netip.Addr) attribute.KeyValue {
+	if ip == nil {
+		return attribute.KeyValue{}
+	}
+	return TargetIPKey.String(ip.String())
+}
+
+// objectAttributes returns the attributes identifying obj, invalid attributes are dropped by start.
+func objectAttributes(obj api.Object) []attribute.KeyValue {
+	switch obj := obj.(type) {
+	case *api.Interface:
+		return []attribute.KeyValue{InterfaceIDKey.String(obj.ID), VNIKey.Int64(int64(obj.Spec.VNI))}
+	case *api.LoadBalancer:
+		return []attribute.KeyValue{LoadBalancerIDKey.String(obj.ID), VNIKey.Int64(int64(obj.Spec.VNI))}
+	case *api.LoadBalancerPrefix:
+		return []attribute.KeyValue{InterfaceIDKey.String(obj.InterfaceID), prefixAttribute(&obj.Spec.Prefix)}
+	case *api.LoadBalancerTarget:
+		return []attribute.KeyValue{LoadBalancerIDKey.String(obj.LoadbalancerID), targetIPAttribute(obj.Spec.TargetIP)}
+	case *api.Prefix:
+		return []attribute.KeyValue{InterfaceIDKey.String(obj.InterfaceID), prefixAttribute(&obj.Spec.Prefix)}
+	case *api.VirtualIP:
+		return []attribute.KeyValue{InterfaceIDKey.String(obj.InterfaceID)}
+	case *api.Route:
+		return []attribute.KeyValue{VNIKey.Int64(int64(obj.VNI)), prefixAttribute(obj.Spec.Prefix)}
+	case *api.Nat:
+		return []attribute.KeyValue{InterfaceIDKey.String(obj.InterfaceID), natIPAttribute(obj.Spec.NatIP)}
+	case *api.NeighborNat:
+		return []attribute.KeyValue{natIPAttribute(obj.NatIP), VNIKey.Int64(int64(obj.Spec.Vni))}
+	case *api.FirewallRule:
+		return []attribute.KeyValue{InterfaceIDKey.String(obj.InterfaceID), FirewallRuleIDKey.String(obj.Spec.RuleID)}
+	default:
+		return nil
+	}
+}
+
+// UnaryClientInterceptor injects the trace context of the call into the gRPC metadata, so dp-service
+// and proxies in between can continue the trace. It can be passed to client.Dial with
+// client.WithDialOptions(grpc.WithChainUnaryInterceptor(...)).
+func UnaryClientInterceptor(opts ...Option) grpc.UnaryClientInterceptor {
+	o := newOptions(opts)
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, callOpts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		md = md.Copy()
+		o.propagator.Inject(ctx, metadataCarrier(md))
+		return invoker(metadata.NewOutgoingContext(ctx, md), method, req, reply, cc, callOpts...)
+	}
+}
+
+// metadataCarrier adapts gRPC metadata to a propagation.TextMapCarrier.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
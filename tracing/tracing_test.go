@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/ironcore-dev/dpservice-go/api"
+	"github.com/ironcore-dev/dpservice-go/client"
+	"github.com/ironcore-dev/dpservice-go/dpservicetest"
+	"github.com/ironcore-dev/dpservice-go/errors"
+	dpdkproto "github.com/ironcore-dev/dpservice-go/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func addr(s string) *netip.Addr {
+	a := netip.MustParseAddr(s)
+	return &a
+}
+
+var _ = Describe("Tracing", func() {
+	var (
+		server   *dpservicetest.Server
+		recorder *tracetest.SpanRecorder
+		provider *sdktrace.TracerProvider
+	)
+
+	BeforeEach(func() {
+		server = dpservicetest.NewServer()
+		server.Start()
+		DeferCleanup(server.Close)
+
+		recorder = tracetest.NewSpanRecorder()
+		provider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	})
+
+	spanNamed := func(name string) sdktrace.ReadOnlySpan {
+		for _, span := range recorder.Ended() {
+			if span.Name() == name {
+				return span
+			}
+		}
+		Fail("no span named " + name)
+		return nil
+	}
+
+	It("should record a span for every call of a wrapped client", func(ctx SpecContext) {
+		conn, err := server.Dial(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(conn.Close)
+		c := WrapClient(client.NewClient(dpdkproto.NewDPDKironcoreClient(conn)), WithTracerProvider(provider))
+
+		_, err = c.Initialize(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = client.AttachVM(ctx, c, client.VMNetworkSpec{
+			ID:        "vm1",
+			Interface: api.InterfaceSpec{VNI: 100, Device: "net_tap2", IPv4: addr("10.200.1.4"), IPv6: addr("2000:200:1::4")},
+			Nat:       &api.NatSpec{NatIP: addr("20.10.0.1"), MinPort: 1024, MaxPort: 2048},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.GetVirtualIP(ctx, "vm1", client.IgnoreErrors(errors.SNAT_NO_DATA))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.DeleteVirtualIP(ctx, "vm1")
+		Expect(err).To(HaveOccurred())
+
+		span := spanNamed("dpservice.CreateInterface")
+		Expect(span.Attributes()).To(ContainElements(InterfaceIDKey.String("vm1"), VNIKey.Int64(100)))
+		Expect(span.Status().Code).To(Equal(codes.Unset))
+
+		span = spanNamed("dpservice.CreateNat")
+		Expect(span.Attributes()).To(ContainElements(InterfaceIDKey.String("vm1"), NatIPKey.String("20.10.0.1")))
+
+		span = spanNamed("dpservice.GetVirtualIP")
+		Expect(span.Attributes()).To(ContainElement(StatusCodeKey.Int64(errors.SNAT_NO_DATA)))
+		Expect(span.Status().Code).To(Equal(codes.Unset))
+
+		span = spanNamed("dpservice.DeleteVirtualIP")
+		Expect(span.Attributes()).To(ContainElements(InterfaceIDKey.String("vm1"), StatusCodeKey.Int64(errors.SNAT_NO_DATA)))
+		Expect(span.Status().Code).To(Equal(codes.Error))
+	})
+
+	It("should propagate the trace context in the gRPC metadata", func(ctx SpecContext) {
+		var md metadata.MD
+		capture := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			md, _ = metadata.FromOutgoingContext(ctx)
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		conn, err := server.Dial(ctx, grpc.WithChainUnaryInterceptor(
+			UnaryClientInterceptor(WithPropagator(propagation.TraceContext{})),
+			capture,
+		))
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(conn.Close)
+		c := WrapClient(client.NewClient(dpdkproto.NewDPDKironcoreClient(conn)), WithTracerProvider(provider))
+
+		_, err = c.Initialize(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		span := spanNamed("dpservice.Initialize")
+		Expect(md.Get("traceparent")).To(ConsistOf(ContainSubstring(span.SpanContext().SpanID().String())))
+		Expect(span.Attributes()).NotTo(ContainElement(WithTransform(func(kv attribute.KeyValue) attribute.Key { return kv.Key }, Equal(StatusCodeKey))))
+	})
+})